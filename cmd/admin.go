@@ -0,0 +1,399 @@
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/configs"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transfer"
+)
+
+// newAdminService — тот же сервис, что у серверов, без трассировки и подписчиков событий
+func newAdminService(cfg *configs.Config, db *sql.DB) usecase.Service {
+	return app.NewService(
+		pg.NewTeamStorage(db),
+		pg.NewUserStorage(db),
+		pg.NewPullRequestStorage(db),
+		pg.NewEventStorage(db),
+		pg.NewStatsStorage(db),
+		pg.NewTxManager(db),
+		events.NewBroker(),
+		metrics.New(nil),
+		serviceOptions(cfg),
+	)
+}
+
+func runSeed(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	teams := fs.Int("teams", 10, "сколько команд создать")
+	members := fs.Int("members", 8, "участников в каждой команде")
+	prs := fs.Int("prs", 100, "сколько PR создать")
+	merged := fs.Float64("merged", 0.3, "доля PR, которые сразу мержатся (0..1)")
+	prefix := fs.String("prefix", "seed", "префикс идентификаторов, чтобы повторный запуск не пересекался с реальными данными")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *teams <= 0 || *members <= 0 || *prs < 0 {
+		return errors.New("teams and members must be positive, prs non-negative")
+	}
+
+	svc := newAdminService(cfg, db)
+
+	// Существующие команды и PR пропускаются: seed можно перезапускать с тем же префиксом
+	var authors []string
+	for t := range *teams {
+		team := entity.Team{Name: fmt.Sprintf("%s-team-%03d", *prefix, t)}
+		for u := range *members {
+			team.Members = append(team.Members, entity.User{
+				ID:       fmt.Sprintf("%s-u-%03d-%03d", *prefix, t, u),
+				Username: fmt.Sprintf("User %d.%d", t, u),
+				IsActive: true,
+			})
+			authors = append(authors, team.Members[u].ID)
+		}
+		if _, err := svc.AddOrUpdateTeam(ctx, team); err != nil && !errors.Is(err, usecase.ErrTeamExists) {
+			return fmt.Errorf("team %s: %w", team.Name, err)
+		}
+	}
+
+	created := 0
+	for i := range *prs {
+		id := fmt.Sprintf("%s-pr-%05d", *prefix, i)
+		_, err := svc.CreatePR(ctx, id, "Seed PR "+id, authors[rand.Intn(len(authors))], entity.NoCandidateEmpty)
+		if errors.Is(err, usecase.ErrPRExists) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("pull request %s: %w", id, err)
+		}
+		created++
+		if rand.Float64() < *merged {
+			if _, err := svc.MergePR(ctx, id, 0); err != nil {
+				return fmt.Errorf("merge %s: %w", id, err)
+			}
+		}
+	}
+
+	slog.InfoContext(ctx, "seed completed", "teams", *teams, "users", *teams**members, "prs_created", created)
+	return nil
+}
+
+func runExport(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	out := fs.String("o", "-", "файл выгрузки, - — stdout")
+	formatName := fs.String("format", "", "jsonl | csv; по умолчанию по расширению -o, иначе jsonl")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	format, err := resolveFormat(*formatName, *out)
+	if err != nil {
+		return err
+	}
+
+	w := os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := transfer.NewWriter(w, format)
+	n := 0
+	err = newAdminService(cfg, db).Export(ctx, func(rec entity.TransferRecord) error {
+		n++
+		return enc.Write(rec)
+	})
+	if err == nil {
+		err = enc.Flush()
+	}
+	if err != nil {
+		return err
+	}
+	if w != os.Stdout {
+		if err := w.Close(); err != nil {
+			return err
+		}
+	}
+
+	slog.InfoContext(ctx, "export completed", "records", n, "format", format)
+	return nil
+}
+
+func runImport(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	in := fs.String("i", "-", "файл с выгрузкой, - — stdin")
+	formatName := fs.String("format", "", "jsonl | csv; по умолчанию по расширению -i, иначе jsonl")
+	dryRun := fs.Bool("dry-run", false, "только проверить файл и показать, что изменится")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	format, err := resolveFormat(*formatName, *in)
+	if err != nil {
+		return err
+	}
+
+	r := os.Stdin
+	if *in != "-" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	result, err := newAdminService(cfg, db).Import(ctx, transfer.NewReader(r, format), *dryRun)
+	if err != nil && !errors.Is(err, usecase.ErrImportConflict) {
+		return err
+	}
+	// Отчёт печатается и при конфликтах: в нём их список
+	if printErr := printJSON(result); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+func resolveFormat(name, path string) (transfer.Format, error) {
+	if name != "" {
+		return transfer.ParseFormat(name)
+	}
+	return transfer.FormatFromPath(path), nil
+}
+
+func runDeactivateTeam(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("deactivate-team", flag.ContinueOnError)
+	teamName := fs.String("team", "", "команда (обязательно)")
+	users := fs.String("users", "", "user_id через запятую; пусто — все активные участники")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *teamName == "" {
+		return errors.New("-team is required")
+	}
+
+	svc := newAdminService(cfg, db)
+	team, err := svc.GetTeam(ctx, *teamName)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	if *users != "" {
+		ids = strings.Split(*users, ",")
+	} else {
+		for _, m := range team.Members {
+			if m.IsActive {
+				ids = append(ids, m.ID)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		slog.InfoContext(ctx, "no active members to deactivate", "team", *teamName)
+		return nil
+	}
+
+	// Версия из только что прочитанной команды: параллельное изменение состава — ошибка, а не молчаливая перезапись
+	return svc.DeactivateUsersAndReassign(ctx, *teamName, ids, team.Version)
+}
+
+func runStats(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	userID := fs.String("user", "", "user_id; пусто — статистика по всем PR")
+	var filter entity.StatsFilter
+	fs.StringVar(&filter.TeamName, "team", "", "только PR авторов из этой команды")
+	fs.Func("from", "PR, созданные не раньше (2006-01-02 или RFC 3339)", timeFlag(&filter.From))
+	fs.Func("to", "PR, созданные раньше (2006-01-02 или RFC 3339)", timeFlag(&filter.To))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	svc := newAdminService(cfg, db)
+	if *userID != "" {
+		stats, err := svc.GetUserStats(ctx, *userID)
+		if err != nil {
+			return err
+		}
+		return printJSON(stats)
+	}
+	stats, err := svc.GetPRStats(ctx, filter)
+	if err != nil {
+		return err
+	}
+	return printJSON(stats)
+}
+
+func runRollup(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("rollup", flag.ContinueOnError)
+	var from *time.Time
+	fs.Func("from", "пересчитать с этого дня (2006-01-02); по умолчанию — с последнего посчитанного", timeFlag(&from))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var start time.Time
+	if from != nil {
+		start = *from
+	}
+	n, err := newAdminService(cfg, db).RollupStats(ctx, start, time.Now())
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "rollup completed", "rows", n)
+	return nil
+}
+
+func runStale(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	fs := flag.NewFlagSet("stale", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Тот же лок, что у планировщика в serve: проход не пересечётся с проходом реплики
+	leader := pg.NewLeaderLock(db, pg.StaleLockKey)
+	ok, err := leader.TryLead(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("another instance is scanning stale PRs, try again later")
+	}
+	defer func() {
+		if err := leader.Release(context.Background()); err != nil {
+			slog.WarnContext(ctx, "failed to release stale scanner lock", "error", err)
+		}
+	}()
+
+	svc := newAdminService(cfg, db)
+	scanner := app.NewStaleScanner(pg.NewPullRequestStorage(db), svc, newNotifier(cfg.Notify), staleSLA(cfg))
+	report, err := scanner.Scan(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	return printJSON(report)
+}
+
+func timeFlag(dst **time.Time) func(string) error {
+	return func(v string) error {
+		for _, layout := range []string{time.DateOnly, time.RFC3339} {
+			if t, err := time.Parse(layout, v); err == nil {
+				*dst = &t
+				return nil
+			}
+		}
+		return fmt.Errorf("expected 2006-01-02 or RFC 3339, got %q", v)
+	}
+}
+
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func runTenant(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	usage := errors.New("usage: tenant add [-name N] [-admin-key K] <id> | tenant list")
+	if len(args) == 0 {
+		return usage
+	}
+	tenants := pg.NewTenantStorage(db)
+
+	switch args[0] {
+	case "list":
+		list, err := tenants.List(ctx)
+		if err != nil {
+			return err
+		}
+		return printJSON(list)
+	case "add":
+		fs := flag.NewFlagSet("tenant add", flag.ContinueOnError)
+		name := fs.String("name", "", "название организации")
+		adminKey := fs.String("admin-key", "", "зарегистрировать admin API-ключ нового арендатора")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return usage
+		}
+		id := fs.Arg(0)
+		if !tenant.Valid(id) {
+			return fmt.Errorf("invalid tenant id %q", id)
+		}
+
+		t, err := tenants.Create(ctx, entity.Tenant{ID: id, Name: *name})
+		if err != nil {
+			return err
+		}
+		// Ключ принадлежит арендатору, в котором создан
+		if *adminKey != "" {
+			if _, err := auth.SaveAPIKey(tenant.NewContext(ctx, id), pg.NewAPIKeyStorage(db), "admin", *adminKey, entity.RoleAdmin, ""); err != nil {
+				return fmt.Errorf("register admin api key: %w", err)
+			}
+		}
+		return printJSON(t)
+	default:
+		return usage
+	}
+}
+
+// runAPIKey — путь к первому ключу при включённой аутентификации: до него API отвечает 401 на всё.
+// Ключ создаётся в арендаторе -tenant; без -key генерируется случайный и печатается один раз.
+func runAPIKey(ctx context.Context, _ *configs.Config, db *sql.DB, args []string) error {
+	usage := errors.New("usage: apikey add [-name N] [-role R] [-team T] [-key K]")
+	if len(args) == 0 || args[0] != "add" {
+		return usage
+	}
+
+	fs := flag.NewFlagSet("apikey add", flag.ContinueOnError)
+	name := fs.String("name", "admin", "название ключа")
+	role := fs.String("role", string(entity.RoleAdmin), "admin | team_lead | member | read_only")
+	team := fs.String("team", "", "команда team_lead")
+	key := fs.String("key", "", "значение ключа; пусто — сгенерировать")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return usage
+	}
+
+	if *key == "" {
+		buf := make([]byte, 32)
+		if _, err := crand.Read(buf); err != nil {
+			return fmt.Errorf("generate api key: %w", err)
+		}
+		*key = hex.EncodeToString(buf)
+	}
+
+	saved, err := auth.SaveAPIKey(ctx, pg.NewAPIKeyStorage(db), *name, *key, entity.Role(*role), *team)
+	if err != nil {
+		return fmt.Errorf("register api key: %w", err)
+	}
+	return printJSON(struct {
+		Name     string      `json:"name"`
+		Role     entity.Role `json:"role"`
+		TeamName string      `json:"team_name,omitempty"`
+		TenantID string      `json:"tenant_id"`
+		Key      string      `json:"key"`
+	}{saved.Name, saved.Role, saved.TeamName, saved.TenantID, *key})
+}
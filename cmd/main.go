@@ -3,93 +3,138 @@ package main
 import (
 	"context"
 	"database/sql"
-	"log"
-	"net/http"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
-	"github.com/go-chi/chi/v5"
-	_ "github.com/lib/pq"
-
-	"github.com/mark47B/be-internship/internal/app"
 	"github.com/mark47B/be-internship/internal/configs"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/infra/logging"
 	"github.com/mark47B/be-internship/internal/infra/storage/pg"
-	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
-	"github.com/mark47B/be-internship/internal/infra/transport/rest/handlers"
 )
 
-func main() {
-	cfg := configs.Load()
+const usage = `usage: app [config flags] [command] [command flags]
+
+commands:
+  serve             HTTP и gRPC серверы (команда по умолчанию)
+  migrate           up | down [N] | version
+  seed              сгенерировать команды, пользователей и PR для нагрузочных тестов
+  export            выгрузить команды и PR в JSONL или CSV
+  import            загрузить команды и PR из JSONL или CSV
+  deactivate-team   деактивировать участников команды с переназначением их открытых PR
+  stats             статистика по PR или пользователю
+  rollup            пересчитать дневные агрегаты для /stats/timeseries
+  stale             один проход планировщика зависших PR: замены ревьюверов и напоминания
+  tenant            add | list — арендаторы (организации) инсталляции
+  apikey add        создать API-ключ (первый admin-ключ при включённой аутентификации)
+  config print      итоговая конфигурация без секретов
+
+Флаги конфигурации: app -h; флаги команды: app <command> -h
+Админские команды работают в данных арендатора -tenant (TENANT), по умолчанию default.
+`
+
+// command — подкоманда бинарника; args — аргументы после её имени
+type command func(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error
+
+var commands = map[string]command{
+	"serve":           runServe,
+	"migrate":         runMigrate,
+	"seed":            runSeed,
+	"export":          runExport,
+	"import":          runImport,
+	"deactivate-team": runDeactivateTeam,
+	"stats":           runStats,
+	"rollup":          runRollup,
+	"stale":           runStale,
+	"tenant":          runTenant,
+	"apikey":          runAPIKey,
+}
 
-	// Connect to database
-	db, err := sql.Open("postgres", cfg.PostgresURL)
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+func main() {
+	cfg, args, err := configs.Load(os.Args[1:], usage)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return
+	case err != nil:
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
 
-	defer func() {
-		if err := db.Close(); err != nil {
-			log.Printf("failed to close db: %v", err)
+	name := "serve"
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" {
+		fmt.Print(usage)
+		return
+	}
+	// config не ходит в БД: его запускают, чтобы проверить настройки до деплоя
+	if name == "config" {
+		if err := runConfig(cfg, args); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
 		}
-	}()
-
-	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+		return
 	}
-
-	// Initialize repositories
-	teamRepo := pg.NewTeamStorage(db)
-	userRepo := pg.NewUserStorage(db)
-	prRepo := pg.NewPullRequestStorage(db)
-	txRepo := pg.NewTxManager(db)
-
-	// Initialize service
-	svc := app.NewService(teamRepo, userRepo, prRepo, txRepo)
-
-	// Initialize handlers
-	h := handlers.NewHandlers(svc)
-
-	// Setup router
-	router := chi.NewRouter()
-	router.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Content-Type", "application/json")
-			next.ServeHTTP(w, r)
-		})
-	})
-
-	// Register handlers
-	gen.HandlerFromMux(h, router)
-
-	// Create HTTP server
-	srv := &http.Server{
-		Addr:    ":" + cfg.Port,
-		Handler: router,
+	run, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", name, usage)
+		os.Exit(2)
 	}
 
-	// Start server
-	go func() {
-		log.Printf("Server starting on port %s", cfg.Port)
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Server failed to start: %v", err)
-		}
-	}()
+	// stdout админских команд — их результат (выгрузка, статистика), логи уходят в stderr
+	var logOut io.Writer = os.Stderr
+	if name == "serve" {
+		logOut = os.Stdout
+	}
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		slog.Warn("using info log level", "error", err)
+	}
+	logging.Setup(logOut, level)
 
-	// Graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	// Connect to database: запросы идут под ролью арендатора с row-level security
+	db, err := pg.Open(cfg.Database.URL, cfg.Database.TenantRole)
+	if err != nil {
+		fatal("failed to connect to database", err)
+	}
+	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.Database.ConnMaxIdleTime)
+	if err := db.Ping(); err != nil {
+		fatal("failed to ping database", err)
+	}
 
-	log.Println("Shutting down server...")
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	ctx = tenant.NewContext(ctx, cfg.Tenant)
+	err = run(ctx, cfg, db, args)
+	stop()
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	if closeErr := db.Close(); closeErr != nil {
+		slog.Error("failed to close db", "error", closeErr)
+	}
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+	case err != nil:
+		fatal(name+" failed", err)
+	}
+}
 
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+func runConfig(cfg *configs.Config, args []string) error {
+	if len(args) != 1 || args[0] != "print" {
+		return fmt.Errorf("usage: app [config flags] config print")
 	}
+	return configs.Print(os.Stdout, cfg)
+}
 
-	log.Println("Server exited")
+// fatal пишет ошибку и завершает процесс, как log.Fatalf
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
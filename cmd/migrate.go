@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/mark47B/be-internship/database/migrations"
+	"github.com/mark47B/be-internship/internal/configs"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+)
+
+var errMigrateUsage = errors.New("usage: app migrate up | down [N] | version")
+
+// runMigrate — подкоманда `migrate`: up, down [N] (по умолчанию одна миграция), version
+func runMigrate(ctx context.Context, _ *configs.Config, db *sql.DB, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+
+	m, err := pg.NewMigrator(ctx, db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			slog.Error("failed to close migrator", "error", err)
+		}
+	}()
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return errMigrateUsage
+		}
+		if err := m.Up(); err != nil {
+			return err
+		}
+	case "down":
+		steps := 1
+		if len(args) == 2 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps %q: %w", args[1], errMigrateUsage)
+			}
+		} else if len(args) > 2 {
+			return errMigrateUsage
+		}
+		if err := m.Down(steps); err != nil {
+			return err
+		}
+	case "version":
+		if len(args) != 1 {
+			return errMigrateUsage
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q: %w", args[0], errMigrateUsage)
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if dirty {
+		fmt.Printf("%d (dirty)\n", version)
+	} else {
+		fmt.Println(version)
+	}
+	return nil
+}
+
+// migrateOnStart применяет миграции перед запуском серверов (MIGRATE_ON_START=true)
+func migrateOnStart(ctx context.Context, db *sql.DB) error {
+	m, err := pg.NewMigrator(ctx, db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			slog.Error("failed to close migrator", "error", err)
+		}
+	}()
+
+	if err := m.Up(); err != nil {
+		return err
+	}
+	version, _, err := m.Version()
+	if err != nil {
+		return err
+	}
+	slog.Info("database schema is up to date", "version", version)
+	return nil
+}
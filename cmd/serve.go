@@ -0,0 +1,439 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+
+	"github.com/mark47B/be-internship/database/migrations"
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/configs"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/health"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
+	"github.com/mark47B/be-internship/internal/infra/notify"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/tracing"
+	grpctransport "github.com/mark47B/be-internship/internal/infra/transport/grpc"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/handlers"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/middleware"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/requestid"
+)
+
+// runServe — HTTP и gRPC серверы; работает до отмены ctx (SIGINT/SIGTERM)
+func runServe(ctx context.Context, cfg *configs.Config, db *sql.DB, args []string) error {
+	if err := flag.NewFlagSet("serve", flag.ContinueOnError).Parse(args); err != nil {
+		return err
+	}
+
+	if cfg.Database.MigrateOnStart {
+		if err := migrateOnStart(ctx, db); err != nil {
+			return fmt.Errorf("apply migrations: %w", err)
+		}
+	}
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.OTLPEndpoint,
+		File:        cfg.Tracing.File,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		ServiceName: cfg.Tracing.ServiceName,
+	})
+	if err != nil {
+		return fmt.Errorf("initialize tracing: %w", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+	}()
+
+	// Initialize repositories
+	teamRepo := tracing.InstrumentTeamRepository(pg.NewTeamStorage(db))
+	userRepo := tracing.InstrumentUserRepository(pg.NewUserStorage(db))
+	prRepo := tracing.InstrumentPullRequestRepository(pg.NewPullRequestStorage(db))
+	eventRepo := tracing.InstrumentEventRepository(pg.NewEventStorage(db))
+	statsRepo := tracing.InstrumentStatsRepository(pg.NewStatsStorage(db))
+	m := metrics.New(db)
+	txRepo := m.InstrumentTxManager(tracing.InstrumentTxManager(pg.NewTxManager(db)))
+	apiKeyRepo := tracing.InstrumentAPIKeyRepository(pg.NewAPIKeyStorage(db))
+	tenantRepo := tracing.InstrumentTenantRepository(pg.NewTenantStorage(db))
+	idempotencyRepo := tracing.InstrumentIdempotencyRepository(pg.NewIdempotencyStorage(db))
+
+	// Event bus for SSE subscribers
+	broker := events.NewBroker()
+
+	// Initialize service
+	svc := tracing.InstrumentService(app.NewService(teamRepo, userRepo, prRepo, eventRepo, statsRepo, txRepo, broker, m, serviceOptions(cfg)))
+
+	authn, err := newAuthenticator(ctx, cfg.Auth, apiKeyRepo, teamRepo, tenantRepo)
+	if err != nil {
+		return fmt.Errorf("initialize authentication: %w", err)
+	}
+
+	schemaVersion, err := migrations.LatestVersion()
+	if err != nil {
+		return fmt.Errorf("read embedded migrations: %w", err)
+	}
+	checker := health.NewChecker(db, schemaVersion, cfg.HTTP.ReadinessTimeout)
+
+	// Initialize handlers
+	h := handlers.NewHandlers(svc, checker)
+
+	// Setup router
+	router := chi.NewRouter()
+	router.Use(requestid.Middleware)
+	router.Use(middleware.Tracing)
+	router.Use(middleware.RequestLogger)
+	router.Use(middleware.Metrics(m))
+	router.Use(middleware.Recoverer)
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("load OpenAPI spec: %w", err)
+	}
+	validator, err := middleware.OpenAPIValidator(swagger, middleware.ValidatorOptions{
+		ValidateResponses: cfg.HTTP.ValidateResponses,
+	})
+	if err != nil {
+		return fmt.Errorf("create request validator: %w", err)
+	}
+	authMiddleware, err := middleware.Authenticate(swagger, authn)
+	if err != nil {
+		return fmt.Errorf("create auth middleware: %w", err)
+	}
+	router.Use(authMiddleware)
+	router.Use(validator)
+	if cfg.Features.Idempotency {
+		router.Use(middleware.Idempotency(idempotencyRepo, cfg.Idempotency.TTL, cfg.Idempotency.Lease))
+	}
+
+	// Register handlers
+	gen.HandlerFromMux(h, router)
+	// Вне OpenAPI-спецификации: проверка запросов и аутентификация его не затрагивают
+	if cfg.Features.Metrics {
+		router.Handle("/metrics", m.Handler())
+	}
+
+	// Create HTTP server
+	srv := &http.Server{
+		Addr:              ":" + strconv.Itoa(cfg.HTTP.Port),
+		Handler:           router,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
+		ReadTimeout:       cfg.HTTP.ReadTimeout,
+		WriteTimeout:      cfg.HTTP.WriteTimeout,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
+	}
+	// Закрываем SSE-потоки, иначе Shutdown ждал бы их до таймаута
+	srv.RegisterOnShutdown(broker.Close)
+
+	// Create gRPC server
+	var grpcSrv *grpc.Server
+	var grpcLis net.Listener
+	if cfg.Features.GRPC {
+		grpcSrv = grpctransport.NewServer(svc, authn)
+		if grpcLis, err = net.Listen("tcp", ":"+strconv.Itoa(cfg.GRPC.Port)); err != nil {
+			return fmt.Errorf("listen gRPC port: %w", err)
+		}
+	}
+
+	// Просроченные ключи идемпотентности не мешают повторному использованию, но занимают место.
+	// Фоновые задачи обходят всех арендаторов.
+	cleanupCtx, stopCleanup := context.WithCancel(ctx)
+	defer stopCleanup()
+	go cleanupIdempotencyKeys(cleanupCtx, tenantRepo, idempotencyRepo, time.Hour)
+	if cfg.Rollup.Interval > 0 {
+		go rollupStats(cleanupCtx, tenantRepo, svc, cfg.Rollup.Interval)
+	}
+	if cfg.Stale.Interval > 0 {
+		scanner := app.NewStaleScanner(prRepo, svc, newNotifier(cfg.Notify), staleSLA(cfg))
+		go scanStalePRs(cleanupCtx, pg.NewLeaderLock(db, pg.StaleLockKey), tenantRepo, scanner, cfg.Stale.Interval)
+	}
+	notifiers, closeNotifiers, err := newAssignmentNotifiers(cfg.Notify)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = closeNotifiers() }()
+	go app.NewAssignmentNotifier(tenantRepo, userRepo, prRepo, eventRepo, svc, pg.NewLeaderLock(db, pg.NotifyLockKey),
+		notifiers, assignmentChannels(cfg.Notify)).Run(cleanupCtx)
+
+	// Start server
+	go func() {
+		slog.Info("HTTP server starting", "port", cfg.HTTP.Port)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fatal("server failed to start", err)
+		}
+	}()
+
+	if grpcSrv != nil {
+		go func() {
+			slog.Info("gRPC server starting", "port", cfg.GRPC.Port)
+			if err := grpcSrv.Serve(grpcLis); err != nil {
+				fatal("gRPC server failed to start", err)
+			}
+		}()
+	}
+
+	// Graceful shutdown: ctx отменяется по SIGINT/SIGTERM
+	<-ctx.Done()
+
+	slog.Info("shutting down server")
+	stopCleanup()
+
+	// Сначала readiness в fail, затем пауза, чтобы новые запросы ушли на другие поды
+	checker.Shutdown()
+	if cfg.HTTP.ShutdownDelay > 0 {
+		time.Sleep(cfg.HTTP.ShutdownDelay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
+	defer cancel()
+
+	// Сначала HTTP: его OnShutdown закрывает шину событий, и gRPC-стримы тоже завершаются
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown HTTP server: %w", err)
+	}
+
+	if grpcSrv != nil {
+		stopped := make(chan struct{})
+		go func() {
+			grpcSrv.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-shutdownCtx.Done():
+			grpcSrv.Stop()
+		}
+	}
+
+	slog.Info("server exited")
+	return nil
+}
+
+// newAuthenticator — bootstrap-ключ регистрируется в арендаторе из ctx (tenant в конфигурации)
+func newAuthenticator(
+	ctx context.Context,
+	cfg configs.AuthConfig,
+	keys repository.APIKeyRepository,
+	teams repository.TeamRepository,
+	tenants repository.TenantRepository,
+) (*auth.Authenticator, error) {
+	if !cfg.Enabled {
+		slog.Warn("authentication is disabled, all requests run as admin")
+		return auth.Disabled(tenants), nil
+	}
+
+	if cfg.BootstrapAPIKey != "" {
+		if _, err := auth.SaveAPIKey(ctx, keys, "bootstrap", cfg.BootstrapAPIKey, entity.RoleAdmin, ""); err != nil {
+			return nil, fmt.Errorf("register bootstrap api key: %w", err)
+		}
+	} else if cfg.JWKSFile == "" {
+		// Пустая инсталляция без ключей отвечает 401 на всё: подсказываем, как получить первый
+		slog.Warn("no bootstrap api key configured; set AUTH_BOOTSTRAP_API_KEY or create a key with `app apikey add`")
+	}
+
+	return auth.NewAuthenticator(keys, teams, tenants, auth.Options{
+		JWKSFile: cfg.JWKSFile,
+		Issuer:   cfg.JWTIssuer,
+		Audience: cfg.JWTAudience,
+	})
+}
+
+func serviceOptions(cfg *configs.Config) app.Options {
+	return app.Options{
+		ReviewersPerPR:       cfg.Reviewers.PerPR,
+		Strategy:             cfg.Reviewers.Strategy,
+		DefaultSLA:           staleSLA(cfg),
+		NotificationChannels: assignmentChannels(cfg.Notify),
+	}
+}
+
+func assignmentChannels(cfg configs.NotifyConfig) []entity.NotificationChannel {
+	var channels []entity.NotificationChannel
+	for _, c := range configs.SplitList(cfg.AssignmentChannels) {
+		channels = append(channels, entity.NotificationChannel(c))
+	}
+	return channels
+}
+
+// newAssignmentNotifiers — настроенные каналы уведомлений о назначениях; file есть всегда.
+// closeFile закрывает файл канала file.
+func newAssignmentNotifiers(cfg configs.NotifyConfig) (channels map[entity.NotificationChannel]usecase.Notifier, closeFile func() error, err error) {
+	out := io.Writer(os.Stdout)
+	closeFile = func() error { return nil }
+	if cfg.File != "" && cfg.File != "-" {
+		f, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open notifications file: %w", err)
+		}
+		out, closeFile = f, f.Close
+	}
+
+	channels = map[entity.NotificationChannel]usecase.Notifier{entity.ChannelFile: notify.NewFile(out)}
+	if cfg.SMTPAddr != "" {
+		channels[entity.ChannelEmail] = notify.NewSMTP(cfg.SMTPAddr, cfg.SMTPFrom, nil)
+	}
+	if cfg.ChatWebhookURL != "" {
+		channels[entity.ChannelChat] = notify.NewChat(cfg.ChatWebhookURL)
+	}
+	return channels, closeFile, nil
+}
+
+func staleSLA(cfg *configs.Config) entity.SLA {
+	return entity.SLA{RemindAfter: cfg.Stale.RemindAfter, ReassignAfter: cfg.Stale.ReassignAfter}
+}
+
+func newNotifier(cfg configs.NotifyConfig) usecase.Notifier {
+	switch cfg.Channel {
+	case "webhook":
+		return notify.NewWebhook(cfg.WebhookURL)
+	case "smtp":
+		return notify.NewSMTP(cfg.SMTPAddr, cfg.SMTPFrom, configs.SplitList(cfg.SMTPTo))
+	default:
+		return notify.NewLog()
+	}
+}
+
+// forEachTenant выполняет fn в контексте каждого арендатора по очереди; ошибка одного не мешает остальным
+func forEachTenant(ctx context.Context, tenants repository.TenantRepository, fn func(ctx context.Context) error) error {
+	list, err := tenants.List(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, t := range list {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := fn(tenant.NewContext(ctx, t.ID)); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", t.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func cleanupIdempotencyKeys(ctx context.Context, tenants repository.TenantRepository, repo repository.IdempotencyRepository, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var deleted int64
+			err := forEachTenant(ctx, tenants, func(ctx context.Context) error {
+				n, err := repo.DeleteExpired(ctx)
+				deleted += n
+				return err
+			})
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to delete expired idempotency keys", "error", err)
+			}
+			if deleted > 0 {
+				slog.InfoContext(ctx, "deleted expired idempotency keys", "count", deleted)
+			}
+		}
+	}
+}
+
+// rollupStats пересчитывает дневные агрегаты сразу при старте и затем каждые every;
+// пересчитывается и последний посчитанный день, поэтому текущий день догоняется на каждом тике
+func rollupStats(ctx context.Context, tenants repository.TenantRepository, svc usecase.StatsUseCase, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		var rows int
+		err := forEachTenant(ctx, tenants, func(ctx context.Context) error {
+			n, err := svc.RollupStats(ctx, time.Time{}, time.Now())
+			rows += n
+			return err
+		})
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			slog.ErrorContext(ctx, "failed to roll up daily stats", "error", err)
+		default:
+			slog.DebugContext(ctx, "daily stats rolled up", "rows", rows)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scanStalePRs ищет зависшие PR каждые every; проход выполняет только реплика-лидер
+func scanStalePRs(ctx context.Context, leader *pg.LeaderLock, tenants repository.TenantRepository, scanner *app.StaleScanner, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	defer func() {
+		// ctx уже отменён, а лидерство нужно отдать сразу, не дожидаясь закрытия соединения
+		if err := leader.Release(context.Background()); err != nil {
+			slog.Warn("failed to release stale scanner leadership", "error", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := leader.TryLead(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "stale scanner leader election failed", "error", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var report entity.StaleReport
+		err = forEachTenant(ctx, tenants, func(ctx context.Context) error {
+			r, err := scanner.Scan(ctx, time.Now())
+			report.Reminded += r.Reminded
+			report.Reassigned += r.Reassigned
+			report.Failed += r.Failed
+			return err
+		})
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "stale PR scan failed", "error", err)
+		}
+		if report.Reminded+report.Reassigned+report.Failed > 0 {
+			slog.InfoContext(ctx, "stale PR scan completed",
+				"reminded", report.Reminded, "reassigned", report.Reassigned, "failed", report.Failed)
+		}
+	}
+}
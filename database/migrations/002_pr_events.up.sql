@@ -0,0 +1,15 @@
+-- Журнал событий по PR и назначениям ревьюверов.
+-- id — сквозная последовательность, по ней SSE-клиенты возобновляют поток (Last-Event-ID).
+-- Без внешних ключей: журнал должен переживать удаление PR/пользователей.
+CREATE TABLE IF NOT EXISTS pr_events (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    pr_id TEXT NOT NULL,
+    author_id TEXT NOT NULL DEFAULT '',
+    team_name TEXT NOT NULL DEFAULT '',
+    reviewer_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Возобновление потока с фильтром по команде
+CREATE INDEX IF NOT EXISTS idx_pr_events_team ON pr_events(team_name, id);
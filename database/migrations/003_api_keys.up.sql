@@ -0,0 +1,12 @@
+-- Статические API-ключи. Сам ключ не хранится, только sha256 от него.
+-- team_name обязателен для team_lead: его права ограничены своей командой.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'team_lead', 'member', 'read_only')),
+    team_name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMP,
+    CHECK (role <> 'team_lead' OR team_name <> '')
+);
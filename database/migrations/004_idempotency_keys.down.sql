@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_idempotency_keys_expires;
+
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,16 @@
+-- Ответы на запросы с Idempotency-Key. scope — субъект вызывающего, чтобы ключи разных клиентов не пересекались.
+-- status_code IS NULL — запрос с этим ключом ещё выполняется.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    scope TEXT NOT NULL,
+    key TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status_code INT,
+    content_type TEXT NOT NULL DEFAULT '',
+    response_body BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (scope, key)
+);
+
+-- Периодическая очистка истёкших ключей
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires ON idempotency_keys(expires_at);
@@ -0,0 +1,4 @@
+-- Версии для оптимистичной блокировки: каждое изменение увеличивает version,
+-- UPDATE выполняется только если версия не изменилась с момента чтения (ETag / If-Match).
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
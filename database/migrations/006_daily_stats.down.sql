@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pr_events_created_at;
+DROP TABLE IF EXISTS daily_stats;
@@ -0,0 +1,21 @@
+-- Дневные агрегаты для графиков: пересчитываются фоновой задачей из pull_requests и pr_events.
+-- Строка команды — user_id = '', строка пользователя — team_name = ''.
+-- merge_hours — время до merge каждого PR, смерженного за день: по нему считается точная медиана
+-- за неделю или месяц, чего нельзя сделать по дневным медианам.
+CREATE TABLE IF NOT EXISTS daily_stats (
+    day DATE NOT NULL,
+    team_name TEXT NOT NULL DEFAULT '',
+    user_id TEXT NOT NULL DEFAULT '',
+    prs_opened INT NOT NULL DEFAULT 0,
+    prs_merged INT NOT NULL DEFAULT 0,
+    reviews_assigned INT NOT NULL DEFAULT 0,
+    reassignments INT NOT NULL DEFAULT 0,
+    merge_hours DOUBLE PRECISION[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (day, team_name, user_id)
+);
+
+-- Ряды по пользователю
+CREATE INDEX IF NOT EXISTS idx_daily_stats_user ON daily_stats(user_id, day) WHERE user_id <> '';
+
+-- Пересчёт по дням событий
+CREATE INDEX IF NOT EXISTS idx_pr_events_created_at ON pr_events(created_at);
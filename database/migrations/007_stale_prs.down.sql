@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_pull_requests_open_created;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE review_assignments DROP COLUMN IF EXISTS assigned_at;
+DROP TABLE IF EXISTS team_sla;
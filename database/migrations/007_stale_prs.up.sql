@@ -0,0 +1,15 @@
+-- SLA команды для открытых PR её авторов. Команды без строки живут по значениям из конфигурации (stale.*).
+-- reassign_after = 0 — ревьюверов не заменять.
+CREATE TABLE IF NOT EXISTS team_sla (
+    team_name TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    remind_after INTERVAL NOT NULL CHECK (remind_after > INTERVAL '0'),
+    reassign_after INTERVAL NOT NULL DEFAULT INTERVAL '0' CHECK (reassign_after >= INTERVAL '0')
+);
+
+-- С какого момента ревьювер держит PR; у существующих назначений — время миграции
+ALTER TABLE review_assignments ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP NOT NULL DEFAULT now();
+
+-- Когда ревьюверам последний раз напоминали о PR: повтор — не раньше чем через remind_after
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_open_created ON pull_requests(created_at) WHERE status = 'OPEN';
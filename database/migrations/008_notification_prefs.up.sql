@@ -0,0 +1,13 @@
+-- Настройки уведомлений о назначениях. Пользователи без строки получают уведомления по каналам
+-- из конфигурации (notify.assignment_channels). Тихие часы — минуты от полуночи в time_zone;
+-- quiet_start > quiet_end — интервал через полночь.
+CREATE TABLE IF NOT EXISTS user_notification_prefs (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    channels TEXT[] NOT NULL DEFAULT '{}' CHECK (channels <@ ARRAY['email', 'chat', 'file']),
+    email TEXT,
+    quiet_start SMALLINT CHECK (quiet_start BETWEEN 0 AND 1439),
+    quiet_end SMALLINT CHECK (quiet_end BETWEEN 0 AND 1439),
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    CHECK ((quiet_start IS NULL) = (quiet_end IS NULL)),
+    CHECK (NOT 'email' = ANY(channels) OR email IS NOT NULL)
+);
@@ -0,0 +1,8 @@
+-- Эскалация назначения, когда в команде не осталось активных кандидатов в ревьюверы:
+-- сначала лид команды, затем участники команды эскалации. NULL — шаг пропускается.
+CREATE TABLE IF NOT EXISTS team_escalation (
+    team_name TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    lead_user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+    escalation_team TEXT REFERENCES teams(name) ON DELETE SET NULL,
+    CHECK (escalation_team <> team_name)
+);
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS trg_team_hierarchy_checks ON teams;
+DROP FUNCTION IF EXISTS fn_team_hierarchy_checks();
+DROP FUNCTION IF EXISTS team_subtree(TEXT);
+
+DROP INDEX IF EXISTS idx_teams_parent_team;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_parent_not_self;
+ALTER TABLE teams DROP COLUMN IF EXISTS parent_team;
@@ -0,0 +1,39 @@
+-- Иерархия команд: организация → отделы → команды. NULL — команда верхнего уровня.
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS parent_team TEXT REFERENCES teams(name) ON DELETE SET NULL;
+ALTER TABLE teams ADD CONSTRAINT teams_parent_not_self CHECK (parent_team <> name);
+
+CREATE INDEX IF NOT EXISTS idx_teams_parent_team ON teams(parent_team);
+
+-- Команда и все её потомки
+CREATE OR REPLACE FUNCTION team_subtree(root TEXT) RETURNS SETOF TEXT LANGUAGE sql STABLE AS $$
+  WITH RECURSIVE sub(name) AS (
+    SELECT name FROM teams WHERE name = root
+    UNION
+    SELECT t.name FROM teams t JOIN sub ON t.parent_team = sub.name
+  )
+  SELECT name FROM sub;
+$$;
+
+/*
+Защита от циклов: новый родитель не может лежать в поддереве команды.
+Проверки параллельных транзакций сериализуются advisory lock'ом 7261004 (7261001–7261003 заняты
+журналом событий, rollup'ом и планировщиком), иначе A → B и B → A прошли бы одновременно.
+*/
+CREATE OR REPLACE FUNCTION fn_team_hierarchy_checks() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  IF NEW.parent_team IS NULL THEN
+    RETURN NEW;
+  END IF;
+  PERFORM pg_advisory_xact_lock(7261004);
+  IF EXISTS (SELECT 1 FROM team_subtree(NEW.name) s WHERE s = NEW.parent_team) THEN
+    RAISE EXCEPTION 'team % cannot be moved under its own subteam %', NEW.name, NEW.parent_team
+      USING ERRCODE = 'check_violation', CONSTRAINT = 'teams_parent_no_cycle';
+  END IF;
+  RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trg_team_hierarchy_checks
+BEFORE INSERT OR UPDATE OF parent_team ON teams
+FOR EACH ROW
+EXECUTE FUNCTION fn_team_hierarchy_checks();
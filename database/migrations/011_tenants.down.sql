@@ -0,0 +1,75 @@
+-- Откат возможен, пока в базе один арендатор: иначе идентификаторы разных арендаторов совпадут
+DROP FUNCTION IF EXISTS api_key_by_hash(TEXT);
+
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY['teams', 'users', 'pull_requests', 'review_assignments', 'pr_events', 'api_keys',
+                           'idempotency_keys', 'daily_stats', 'team_sla', 'user_notification_prefs', 'team_escalation'] LOOP
+    EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', t);
+    EXECUTE format('ALTER TABLE %I DISABLE ROW LEVEL SECURITY', t);
+  END LOOP;
+END $$;
+
+-- Роль общая для кластера и может использоваться другими базами: забираем только права
+ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE SELECT, INSERT, UPDATE, DELETE ON TABLES FROM pr_reviewer_tenant;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE USAGE, SELECT ON SEQUENCES FROM pr_reviewer_tenant;
+REVOKE ALL ON ALL TABLES IN SCHEMA public FROM pr_reviewer_tenant;
+REVOKE ALL ON ALL SEQUENCES IN SCHEMA public FROM pr_reviewer_tenant;
+REVOKE USAGE ON SCHEMA public FROM pr_reviewer_tenant;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_parent_team_fkey;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey;
+ALTER TABLE review_assignments DROP CONSTRAINT IF EXISTS review_assignments_pr_id_fkey;
+ALTER TABLE review_assignments DROP CONSTRAINT IF EXISTS review_assignments_reviewer_id_fkey;
+ALTER TABLE team_sla DROP CONSTRAINT IF EXISTS team_sla_team_name_fkey;
+ALTER TABLE user_notification_prefs DROP CONSTRAINT IF EXISTS user_notification_prefs_user_id_fkey;
+ALTER TABLE team_escalation DROP CONSTRAINT IF EXISTS team_escalation_team_name_fkey;
+ALTER TABLE team_escalation DROP CONSTRAINT IF EXISTS team_escalation_lead_user_id_fkey;
+ALTER TABLE team_escalation DROP CONSTRAINT IF EXISTS team_escalation_escalation_team_fkey;
+
+ALTER TABLE teams DROP CONSTRAINT teams_pkey, ADD PRIMARY KEY (name);
+ALTER TABLE users DROP CONSTRAINT users_pkey, ADD PRIMARY KEY (id);
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey, ADD PRIMARY KEY (id);
+ALTER TABLE review_assignments DROP CONSTRAINT review_assignments_pkey, ADD PRIMARY KEY (pr_id, reviewer_id);
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey, ADD PRIMARY KEY (scope, key);
+ALTER TABLE daily_stats DROP CONSTRAINT daily_stats_pkey, ADD PRIMARY KEY (day, team_name, user_id);
+ALTER TABLE team_sla DROP CONSTRAINT team_sla_pkey, ADD PRIMARY KEY (team_name);
+ALTER TABLE user_notification_prefs DROP CONSTRAINT user_notification_prefs_pkey, ADD PRIMARY KEY (user_id);
+ALTER TABLE team_escalation DROP CONSTRAINT team_escalation_pkey, ADD PRIMARY KEY (team_name);
+
+ALTER TABLE users ADD CONSTRAINT users_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE SET NULL;
+ALTER TABLE teams ADD CONSTRAINT teams_parent_team_fkey
+    FOREIGN KEY (parent_team) REFERENCES teams(name) ON DELETE SET NULL;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_author_id_fkey
+    FOREIGN KEY (author_id) REFERENCES users(id);
+ALTER TABLE review_assignments ADD CONSTRAINT review_assignments_pr_id_fkey
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(id) ON DELETE CASCADE;
+ALTER TABLE review_assignments ADD CONSTRAINT review_assignments_reviewer_id_fkey
+    FOREIGN KEY (reviewer_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE team_sla ADD CONSTRAINT team_sla_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE;
+ALTER TABLE user_notification_prefs ADD CONSTRAINT user_notification_prefs_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE team_escalation ADD CONSTRAINT team_escalation_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE;
+ALTER TABLE team_escalation ADD CONSTRAINT team_escalation_lead_user_id_fkey
+    FOREIGN KEY (lead_user_id) REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE team_escalation ADD CONSTRAINT team_escalation_escalation_team_fkey
+    FOREIGN KEY (escalation_team) REFERENCES teams(name) ON DELETE SET NULL;
+
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY['teams', 'users', 'pull_requests', 'review_assignments', 'pr_events', 'api_keys',
+                           'idempotency_keys', 'daily_stats', 'team_sla', 'user_notification_prefs', 'team_escalation'] LOOP
+    EXECUTE format('ALTER TABLE %I DROP COLUMN IF EXISTS tenant_id', t);
+  END LOOP;
+END $$;
+
+DROP FUNCTION IF EXISTS current_tenant();
+DROP TABLE IF EXISTS tenants;
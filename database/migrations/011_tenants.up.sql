@@ -0,0 +1,116 @@
+-- Арендаторы: несколько организаций в одной инсталляции. Все данные получают tenant_id,
+-- идентификаторы команд, пользователей и PR уникальны только внутри арендатора.
+-- Существующие данные переезжают в арендатора default.
+CREATE TABLE IF NOT EXISTS tenants (
+    id TEXT PRIMARY KEY CHECK (id ~ '^[a-z0-9][a-z0-9_-]{0,62}$'),
+    name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+INSERT INTO tenants (id, name) VALUES ('default', 'Default') ON CONFLICT (id) DO NOTHING;
+
+-- Арендатор сессии: сервис выставляет app.tenant_id перед запросами (pg.Open). Не выставлен — NULL:
+-- политики не пропускают ни одной строки, а вставка падает на NOT NULL.
+CREATE OR REPLACE FUNCTION current_tenant() RETURNS TEXT LANGUAGE sql STABLE AS $$
+  SELECT NULLIF(current_setting('app.tenant_id', true), '');
+$$;
+
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY['teams', 'users', 'pull_requests', 'review_assignments', 'api_keys', 'idempotency_keys',
+                           'daily_stats', 'team_sla', 'user_notification_prefs', 'team_escalation'] LOOP
+    EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT %L REFERENCES tenants(id)', t, 'default');
+    EXECUTE format('ALTER TABLE %I ALTER COLUMN tenant_id SET DEFAULT current_tenant()', t);
+  END LOOP;
+END $$;
+
+-- Журнал событий по-прежнему без внешних ключей
+ALTER TABLE pr_events ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE pr_events ALTER COLUMN tenant_id SET DEFAULT current_tenant();
+
+-- Ключи и ссылки — внутри арендатора: сначала внешние ключи, они держатся за первичные
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_parent_team_fkey;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey;
+ALTER TABLE review_assignments DROP CONSTRAINT IF EXISTS review_assignments_pr_id_fkey;
+ALTER TABLE review_assignments DROP CONSTRAINT IF EXISTS review_assignments_reviewer_id_fkey;
+ALTER TABLE team_sla DROP CONSTRAINT IF EXISTS team_sla_team_name_fkey;
+ALTER TABLE user_notification_prefs DROP CONSTRAINT IF EXISTS user_notification_prefs_user_id_fkey;
+ALTER TABLE team_escalation DROP CONSTRAINT IF EXISTS team_escalation_team_name_fkey;
+ALTER TABLE team_escalation DROP CONSTRAINT IF EXISTS team_escalation_lead_user_id_fkey;
+ALTER TABLE team_escalation DROP CONSTRAINT IF EXISTS team_escalation_escalation_team_fkey;
+
+ALTER TABLE teams DROP CONSTRAINT teams_pkey, ADD PRIMARY KEY (tenant_id, name);
+ALTER TABLE users DROP CONSTRAINT users_pkey, ADD PRIMARY KEY (tenant_id, id);
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey, ADD PRIMARY KEY (tenant_id, id);
+ALTER TABLE review_assignments DROP CONSTRAINT review_assignments_pkey, ADD PRIMARY KEY (tenant_id, pr_id, reviewer_id);
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey, ADD PRIMARY KEY (tenant_id, scope, key);
+ALTER TABLE daily_stats DROP CONSTRAINT daily_stats_pkey, ADD PRIMARY KEY (tenant_id, day, team_name, user_id);
+ALTER TABLE team_sla DROP CONSTRAINT team_sla_pkey, ADD PRIMARY KEY (tenant_id, team_name);
+ALTER TABLE user_notification_prefs DROP CONSTRAINT user_notification_prefs_pkey, ADD PRIMARY KEY (tenant_id, user_id);
+ALTER TABLE team_escalation DROP CONSTRAINT team_escalation_pkey, ADD PRIMARY KEY (tenant_id, team_name);
+
+-- SET NULL только по ссылочной колонке: tenant_id обнулять нельзя
+ALTER TABLE users ADD CONSTRAINT users_team_name_fkey
+    FOREIGN KEY (tenant_id, team_name) REFERENCES teams(tenant_id, name) ON DELETE SET NULL (team_name);
+ALTER TABLE teams ADD CONSTRAINT teams_parent_team_fkey
+    FOREIGN KEY (tenant_id, parent_team) REFERENCES teams(tenant_id, name) ON DELETE SET NULL (parent_team);
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_author_id_fkey
+    FOREIGN KEY (tenant_id, author_id) REFERENCES users(tenant_id, id);
+ALTER TABLE review_assignments ADD CONSTRAINT review_assignments_pr_id_fkey
+    FOREIGN KEY (tenant_id, pr_id) REFERENCES pull_requests(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE review_assignments ADD CONSTRAINT review_assignments_reviewer_id_fkey
+    FOREIGN KEY (tenant_id, reviewer_id) REFERENCES users(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE team_sla ADD CONSTRAINT team_sla_team_name_fkey
+    FOREIGN KEY (tenant_id, team_name) REFERENCES teams(tenant_id, name) ON DELETE CASCADE;
+ALTER TABLE user_notification_prefs ADD CONSTRAINT user_notification_prefs_user_id_fkey
+    FOREIGN KEY (tenant_id, user_id) REFERENCES users(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE team_escalation ADD CONSTRAINT team_escalation_team_name_fkey
+    FOREIGN KEY (tenant_id, team_name) REFERENCES teams(tenant_id, name) ON DELETE CASCADE;
+ALTER TABLE team_escalation ADD CONSTRAINT team_escalation_lead_user_id_fkey
+    FOREIGN KEY (tenant_id, lead_user_id) REFERENCES users(tenant_id, id) ON DELETE SET NULL (lead_user_id);
+ALTER TABLE team_escalation ADD CONSTRAINT team_escalation_escalation_team_fkey
+    FOREIGN KEY (tenant_id, escalation_team) REFERENCES teams(tenant_id, name) ON DELETE SET NULL (escalation_team);
+
+/*
+Row-level security: строка видна и изменяема только в сессии её арендатора.
+Суперпользователь и владелец таблиц RLS не подчиняются, поэтому сервис работает под ролью
+pr_reviewer_tenant (SET ROLE при подключении, см. database.tenant_role). Роль общая для кластера:
+создаётся, только если её ещё нет; если её заранее завёл DBA, членство в ней выдаёт тоже он.
+*/
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'pr_reviewer_tenant') THEN
+    CREATE ROLE pr_reviewer_tenant NOLOGIN NOBYPASSRLS;
+    GRANT pr_reviewer_tenant TO CURRENT_USER;
+  END IF;
+END $$;
+
+GRANT USAGE ON SCHEMA public TO pr_reviewer_tenant;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO pr_reviewer_tenant;
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO pr_reviewer_tenant;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO pr_reviewer_tenant;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO pr_reviewer_tenant;
+
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY['teams', 'users', 'pull_requests', 'review_assignments', 'pr_events', 'api_keys',
+                           'idempotency_keys', 'daily_stats', 'team_sla', 'user_notification_prefs', 'team_escalation'] LOOP
+    EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+    EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant())', t);
+  END LOOP;
+END $$;
+
+-- Ключ ищется по хешу до того, как известен арендатор. Политику не ослабляем: поиск идёт через функцию
+-- владельца таблиц (SECURITY DEFINER, RLS на владельца не действует) и отдаёт только ключ с известным хешем —
+-- перечислить чужие ключи через неё нельзя
+CREATE OR REPLACE FUNCTION api_key_by_hash(hash TEXT) RETURNS SETOF api_keys
+LANGUAGE sql STABLE SECURITY DEFINER SET search_path = public AS $$
+  SELECT * FROM api_keys WHERE key_hash = hash;
+$$;
+REVOKE ALL ON FUNCTION api_key_by_hash(TEXT) FROM PUBLIC;
+GRANT EXECUTE ON FUNCTION api_key_by_hash(TEXT) TO pr_reviewer_tenant;
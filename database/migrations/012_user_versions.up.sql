@@ -0,0 +1,3 @@
+-- Версия пользователя: смена активности — compare-and-swap по строке пользователя, а не по команде,
+-- поэтому параллельные изменения разных участников одной команды не конфликтуют.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
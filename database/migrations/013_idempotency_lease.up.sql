@@ -0,0 +1,10 @@
+-- Незавершённый запрос держит ключ только до locked_until: если процесс упал между резервом и сохранением
+-- ответа, ключ снова можно занять, не дожидаясь expires_at.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+UPDATE idempotency_keys SET locked_until = created_at WHERE status_code IS NULL;
+
+-- Повтор отдаёт заголовки ответа (ETag и др.), а не только Content-Type
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS response_headers JSONB NOT NULL DEFAULT '{}';
+UPDATE idempotency_keys SET response_headers = jsonb_build_object('Content-Type', jsonb_build_array(content_type))
+WHERE content_type <> '';
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS content_type;
@@ -0,0 +1,15 @@
+-- Позиции фоновых читателей журнала событий по арендаторам: последний обработанный pr_events.id.
+-- Уведомления о назначениях рассылает одна реплика-лидер; после перезапуска или смены лидера
+-- рассылка продолжается с сохранённой позиции, а не с того, что успела увидеть шина процесса.
+CREATE TABLE IF NOT EXISTS event_cursors (
+    tenant_id TEXT NOT NULL DEFAULT current_tenant() REFERENCES tenants(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    last_event_id BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (tenant_id, name)
+);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON event_cursors TO pr_reviewer_tenant;
+ALTER TABLE event_cursors ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON event_cursors
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
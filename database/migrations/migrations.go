@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// FS — SQL-миграции, вшитые в бинарник
+//
+//go:embed *.sql
+var FS embed.FS
+
+// LatestVersion — версия последней миграции (префикс имени файла 005_versions.up.sql → 5).
+// Это версия схемы, с которой работает бинарник.
+func LatestVersion() (uint, error) {
+	files, err := fs.Glob(FS, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, name := range files {
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return 0, fmt.Errorf("migration %q has no version prefix", name)
+		}
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %q: %w", name, err)
+		}
+		latest = max(latest, uint(v))
+	}
+	return latest, nil
+}
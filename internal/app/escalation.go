@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+// Уровни эскалации — значения label target в метрике Escalated
+const (
+	escalatedToLead   = "lead"
+	escalatedToTeam   = "team"
+	escalatedToParent = "parent"
+)
+
+func (s *ServiceImpl) GetTeamEscalation(ctx context.Context, teamName string) (entity.EscalationPolicy, error) {
+	if _, err := s.teams.Get(ctx, teamName); err != nil {
+		return entity.EscalationPolicy{}, err
+	}
+	return s.teams.GetEscalation(ctx, teamName)
+}
+
+func (s *ServiceImpl) SetTeamEscalation(ctx context.Context, policy entity.EscalationPolicy) (entity.EscalationPolicy, error) {
+	if policy.EscalationTeam == policy.TeamName {
+		return entity.EscalationPolicy{}, errors.New("team cannot escalate to itself")
+	}
+	if _, err := s.teams.Get(ctx, policy.TeamName); err != nil {
+		return entity.EscalationPolicy{}, err
+	}
+	if policy.LeadUserID != "" {
+		if _, err := s.users.Get(ctx, policy.LeadUserID); err != nil {
+			return entity.EscalationPolicy{}, err
+		}
+	}
+	if policy.EscalationTeam != "" {
+		if _, err := s.teams.Get(ctx, policy.EscalationTeam); err != nil {
+			return entity.EscalationPolicy{}, err
+		}
+	}
+	if err := s.teams.SaveEscalation(ctx, policy); err != nil {
+		return entity.EscalationPolicy{}, err
+	}
+	return policy, nil
+}
+
+// escalation — кандидаты по правилам эскалации команды, по уровням: активный лид, активные участники
+// команды эскалации, затем активные участники родительских команд от ближайшей вверх
+type escalation struct {
+	tiers []escalationTier
+}
+
+type escalationTier struct {
+	target string
+	ids    []string
+}
+
+func (s *ServiceImpl) escalation(ctx context.Context, teamName string) (escalation, error) {
+	policy, err := s.teams.GetEscalation(ctx, teamName)
+	if err != nil {
+		return escalation{}, err
+	}
+
+	var e escalation
+	if policy.LeadUserID != "" {
+		lead, err := s.users.Get(ctx, policy.LeadUserID)
+		switch {
+		case err == nil && lead.IsActive:
+			e.tiers = append(e.tiers, escalationTier{target: escalatedToLead, ids: []string{lead.ID}})
+		case err != nil && !errors.Is(err, usecase.ErrUserNotFound):
+			return escalation{}, err
+		}
+	}
+	if policy.EscalationTeam != "" {
+		members, err := s.users.GetActiveByTeam(ctx, policy.EscalationTeam, "")
+		if err != nil {
+			return escalation{}, err
+		}
+		e.tiers = append(e.tiers, escalationTier{target: escalatedToTeam, ids: idsOf(members)})
+	}
+
+	ancestors, err := s.teams.Ancestors(ctx, teamName)
+	if err != nil {
+		return escalation{}, err
+	}
+	for _, parent := range ancestors {
+		members, err := s.users.GetActiveByTeam(ctx, parent, "")
+		if err != nil {
+			return escalation{}, err
+		}
+		e.tiers = append(e.tiers, escalationTier{target: escalatedToParent, ids: idsOf(members)})
+	}
+	return e, nil
+}
+
+// candidates — первый уровень, где остался кто-то кроме excluded.
+// target — уровень для метрик; пустой — эскалация не помогла.
+func (e escalation) candidates(excluded func(id string) bool) (ids []string, target string) {
+	for _, t := range e.tiers {
+		if ids := slices.DeleteFunc(slices.Clone(t.ids), excluded); len(ids) > 0 {
+			return ids, t.target
+		}
+	}
+	return nil, ""
+}
+
+// all — кандидаты всех уровней
+func (e escalation) all() []string {
+	var ids []string
+	for _, t := range e.tiers {
+		ids = append(ids, t.ids...)
+	}
+	return ids
+}
+
+// targetOf — уровень, с которого взят id; пусто — id не из эскалации
+func (e escalation) targetOf(id string) string {
+	for _, t := range e.tiers {
+		if slices.Contains(t.ids, id) {
+			return t.target
+		}
+	}
+	return ""
+}
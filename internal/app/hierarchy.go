@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+func (s *ServiceImpl) SetTeamParent(ctx context.Context, teamName, parent string) (entity.Team, error) {
+	if parent == teamName {
+		return entity.Team{}, usecase.ErrTeamCycle
+	}
+	err := s.txManager.Do(ctx, func(txCtx context.Context) error {
+		if _, err := s.teams.Get(txCtx, teamName); err != nil {
+			return err
+		}
+		if parent != "" {
+			if _, err := s.teams.Get(txCtx, parent); err != nil {
+				return err
+			}
+		}
+		// Окончательная проверка на цикл — в триггере под advisory lock: параллельный перенос
+		// мог изменить дерево после этого чтения
+		return s.teams.SetParent(txCtx, teamName, parent)
+	})
+	if err != nil {
+		return entity.Team{}, err
+	}
+	return s.teams.Get(ctx, teamName)
+}
+
+func (s *ServiceImpl) GetTeamTree(ctx context.Context, teamName string) ([]entity.TeamNode, error) {
+	if teamName != "" {
+		if _, err := s.teams.Get(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	nodes, err := s.teams.Hierarchy(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return buildTree(nodes, teamName), nil
+}
+
+// buildTree собирает плоский список Hierarchy в деревья и сворачивает показатели: Total узла —
+// его Own плюс Total дочерних. Корни — root или, без него, команды верхнего уровня.
+func buildTree(nodes []entity.TeamNode, root string) []entity.TeamNode {
+	children := make(map[string][]entity.TeamNode)
+	var roots []entity.TeamNode
+	for _, n := range nodes {
+		if n.Name == root || (root == "" && n.ParentName == "") {
+			roots = append(roots, n)
+			continue
+		}
+		children[n.ParentName] = append(children[n.ParentName], n)
+	}
+
+	var build func(n entity.TeamNode) entity.TeamNode
+	build = func(n entity.TeamNode) entity.TeamNode {
+		n.Total = n.Own
+		n.Subteams = []entity.TeamNode{}
+		for _, c := range children[n.Name] {
+			c = build(c)
+			n.Total.Add(c.Total)
+			n.Subteams = append(n.Subteams, c)
+		}
+		return n
+	}
+
+	trees := make([]entity.TeamNode, 0, len(roots))
+	for _, r := range roots {
+		trees = append(trees, build(r))
+	}
+	return trees
+}
@@ -0,0 +1,326 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+func (s *ServiceImpl) GetNotificationPrefs(ctx context.Context, userID string) (entity.NotificationPrefs, error) {
+	if _, err := s.users.Get(ctx, userID); err != nil {
+		return entity.NotificationPrefs{}, err
+	}
+	saved, err := s.users.GetNotificationPrefs(ctx, []string{userID})
+	if err != nil {
+		return entity.NotificationPrefs{}, err
+	}
+	if len(saved) == 0 {
+		return entity.NotificationPrefs{UserID: userID, Channels: s.notifyDefaults, Default: true}, nil
+	}
+	return saved[0], nil
+}
+
+func (s *ServiceImpl) SetNotificationPrefs(ctx context.Context, prefs entity.NotificationPrefs) (entity.NotificationPrefs, error) {
+	for _, c := range prefs.Channels {
+		if !c.Valid() {
+			return entity.NotificationPrefs{}, fmt.Errorf("invalid notification channel %q", c)
+		}
+		if c == entity.ChannelEmail && prefs.Email == "" {
+			return entity.NotificationPrefs{}, fmt.Errorf("email channel requires an address")
+		}
+	}
+	if _, err := s.users.Get(ctx, prefs.UserID); err != nil {
+		return entity.NotificationPrefs{}, err
+	}
+	if err := s.users.SaveNotificationPrefs(ctx, prefs); err != nil {
+		return entity.NotificationPrefs{}, err
+	}
+	prefs.Default = false
+	return prefs, nil
+}
+
+const (
+	// notifyCursor — имя позиции рассылки в журнале событий
+	notifyCursor = "assignment_notifier"
+	// notifyBatch — сколько событий арендатора читается из журнала за запрос
+	notifyBatch = 500
+	// notifyPollInterval — как часто читать журнал без сигнала шины: события других реплик в неё не попадают
+	notifyPollInterval = time.Second
+	// Доставку ведут воркеры, чтобы медленный SMTP или webhook не задерживал чтение журнала целиком
+	deliveryWorkers   = 4
+	deliveryQueueSize = 256
+)
+
+// Leader — лидерство среди реплик (pg.LeaderLock): рассылает только реплика, которая его держит
+type Leader interface {
+	TryLead(ctx context.Context) (bool, error)
+	Release(ctx context.Context) error
+}
+
+// AssignmentNotifier сообщает ревьюверам о назначениях (REVIEWER_ASSIGNED) — при создании PR, переназначении
+// и деактивации — по каналам из настроек пользователя. Рассылает одна реплика-лидер: она читает журнал
+// каждого арендатора от сохранённой позиции и сдвигает позицию, когда пачка доставлена, поэтому каждое
+// назначение уведомляется один раз, на какой бы реплике оно ни было записано. Повтор возможен только для
+// пачки, доставку которой прервала остановка лидера. Шина процесса лишь будит чтение журнала и может
+// отключить отстающего подписчика без потерь. Доставка — best effort: ошибки канала только логируются,
+// а в тихие часы уведомление не отправляется вовсе.
+type AssignmentNotifier struct {
+	tenants  repository.TenantRepository
+	users    repository.UserRepository
+	prs      repository.PullRequestRepository
+	journal  repository.EventRepository
+	events   usecase.EventUseCase
+	leader   Leader
+	channels map[entity.NotificationChannel]usecase.Notifier
+	defaults []entity.NotificationChannel
+}
+
+// NewAssignmentNotifier — channels содержит только настроенные каналы; defaults — каналы пользователей
+// без своих настроек
+func NewAssignmentNotifier(
+	tenants repository.TenantRepository,
+	users repository.UserRepository,
+	prs repository.PullRequestRepository,
+	journal repository.EventRepository,
+	events usecase.EventUseCase,
+	leader Leader,
+	channels map[entity.NotificationChannel]usecase.Notifier,
+	defaults []entity.NotificationChannel,
+) *AssignmentNotifier {
+	return &AssignmentNotifier{
+		tenants:  tenants,
+		users:    users,
+		prs:      prs,
+		journal:  journal,
+		events:   events,
+		leader:   leader,
+		channels: channels,
+		defaults: defaults,
+	}
+}
+
+// delivery — уведомление об одном назначении; ctx — в арендаторе события
+type delivery struct {
+	ctx   context.Context
+	event entity.Event
+	prefs entity.NotificationPrefs
+	now   time.Time
+	done  *sync.WaitGroup
+}
+
+// Run рассылает уведомления до отмены ctx
+func (n *AssignmentNotifier) Run(ctx context.Context) {
+	queue := make(chan delivery, deliveryQueueSize)
+	var workers sync.WaitGroup
+	for range deliveryWorkers {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for d := range queue {
+				n.deliver(d)
+				d.done.Done()
+			}
+		}()
+	}
+	defer func() {
+		close(queue)
+		workers.Wait()
+		// ctx уже отменён, а лидерство нужно отдать сразу, не дожидаясь закрытия соединения
+		if err := n.leader.Release(context.Background()); err != nil {
+			slog.Warn("failed to release assignment notifier leadership", "error", err)
+		}
+	}()
+
+	ticker := time.NewTicker(notifyPollInterval)
+	defer ticker.Stop()
+	wake, unsubscribe := n.events.SubscribeEvents(entity.EventFilter{})
+	defer func() { unsubscribe() }()
+
+	for {
+		n.poll(ctx, queue)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if wake == nil {
+				wake, unsubscribe = n.events.SubscribeEvents(entity.EventFilter{})
+			}
+		case _, ok := <-wake:
+			if !ok {
+				// Шина отключила отстающего подписчика или остановлена: события остались в журнале,
+				// переподписываемся на следующем тике
+				unsubscribe()
+				wake, unsubscribe = nil, func() {}
+				continue
+			}
+			// Сигналы, накопившиеся до чтения журнала, он и покроет
+			for len(wake) > 0 {
+				<-wake
+			}
+		}
+	}
+}
+
+// poll дочитывает журнал всех арендаторов, если эта реплика — лидер
+func (n *AssignmentNotifier) poll(ctx context.Context, queue chan<- delivery) {
+	ok, err := n.leader.TryLead(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.ErrorContext(ctx, "assignment notifier leader election failed", "error", err)
+		}
+		return
+	}
+	if !ok {
+		return
+	}
+
+	tenants, err := n.tenants.List(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "failed to list tenants for notifications", "error", err)
+		}
+		return
+	}
+	for _, t := range tenants {
+		if err := n.pollTenant(tenant.NewContext(ctx, t.ID), queue); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "failed to send assignment notifications", "tenant", t.ID, "error", err)
+		}
+	}
+}
+
+// pollTenant отправляет уведомления о событиях арендатора после сохранённой позиции. Запись событий
+// сериализуется внутри арендатора, поэтому событие с меньшим id не закоммитится после прочитанного.
+func (n *AssignmentNotifier) pollTenant(ctx context.Context, queue chan<- delivery) error {
+	lastID, err := n.journal.Cursor(ctx, notifyCursor)
+	if err != nil {
+		return err
+	}
+	for {
+		events, err := n.events.ListEvents(ctx, lastID, entity.EventFilter{}, notifyBatch)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		deliveries, err := n.plan(ctx, events, time.Now())
+		if err != nil {
+			return err
+		}
+		var done sync.WaitGroup
+		done.Add(len(deliveries))
+		for _, d := range deliveries {
+			d.done = &done
+			queue <- d
+		}
+		done.Wait()
+		// Прерванная пачка не отмечается: новый лидер отправит её заново
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastID = events[len(events)-1].ID
+		if err := n.journal.SaveCursor(ctx, notifyCursor, lastID); err != nil {
+			return err
+		}
+		if len(events) < notifyBatch {
+			return nil
+		}
+	}
+}
+
+// plan выбирает из events назначения, о которых нужно уведомить на момент now, и каналы для каждого
+func (n *AssignmentNotifier) plan(ctx context.Context, events []entity.Event, now time.Time) ([]delivery, error) {
+	var assigned []entity.Event
+	var reviewers []string
+	for _, e := range events {
+		if e.Type == entity.EventReviewerAssigned && e.ReviewerID != "" {
+			assigned = append(assigned, e)
+			reviewers = append(reviewers, e.ReviewerID)
+		}
+	}
+	if len(assigned) == 0 {
+		return nil, nil
+	}
+
+	saved, err := n.users.GetNotificationPrefs(ctx, reviewers)
+	if err != nil {
+		return nil, fmt.Errorf("load notification preferences: %w", err)
+	}
+	prefs := make(map[string]entity.NotificationPrefs, len(saved))
+	for _, p := range saved {
+		prefs[p.UserID] = p
+	}
+
+	var deliveries []delivery
+	for _, e := range assigned {
+		p, ok := prefs[e.ReviewerID]
+		if !ok {
+			p = entity.NotificationPrefs{UserID: e.ReviewerID, Channels: n.defaults, Default: true}
+		}
+		if len(p.Channels) == 0 {
+			continue
+		}
+		if p.QuietHours != nil && p.QuietHours.Contains(now) {
+			slog.DebugContext(ctx, "assignment notification skipped: quiet hours", "pr_id", e.PRID, "user_id", e.ReviewerID)
+			continue
+		}
+		deliveries = append(deliveries, delivery{ctx: ctx, event: e, prefs: p, now: now})
+	}
+	return deliveries, nil
+}
+
+// deliver отправляет уведомление по всем каналам получателя
+func (n *AssignmentNotifier) deliver(d delivery) {
+	ctx, e, p := d.ctx, d.event, d.prefs
+	msg := n.assignmentNotification(ctx, e, d.now)
+	for _, c := range p.Channels {
+		channel, ok := n.channels[c]
+		if !ok {
+			slog.WarnContext(ctx, "notification channel is not configured", "channel", c, "user_id", e.ReviewerID)
+			continue
+		}
+		msg.To = nil
+		if c == entity.ChannelEmail {
+			msg.To = []string{p.Email}
+		}
+		if err := channel.Notify(ctx, msg); err != nil {
+			slog.WarnContext(ctx, "failed to send assignment notification",
+				"channel", c, "pr_id", e.PRID, "user_id", e.ReviewerID, "error", err)
+		}
+	}
+}
+
+func (n *AssignmentNotifier) assignmentNotification(ctx context.Context, e entity.Event, now time.Time) entity.Notification {
+	// Имя PR — только для текста: без него уведомление всё равно полезно
+	var name string
+	if pr, err := n.prs.Get(ctx, e.PRID); err == nil {
+		name = pr.Name
+	} else {
+		slog.DebugContext(ctx, "failed to load PR for notification", "pr_id", e.PRID, "error", err)
+	}
+	text := fmt.Sprintf("You were assigned to review pull request %s by %s.", e.PRID, e.AuthorID)
+	if name != "" {
+		text = fmt.Sprintf("You were assigned to review pull request %s (%s) by %s.", e.PRID, name, e.AuthorID)
+	}
+	return entity.Notification{
+		Kind:       entity.NotificationReviewAssigned,
+		PRID:       e.PRID,
+		PRName:     name,
+		AuthorID:   e.AuthorID,
+		TeamName:   e.TeamName,
+		Recipients: []string{e.ReviewerID},
+		Subject:    fmt.Sprintf("Review requested: pull request %s", e.PRID),
+		Text:       text,
+		CreatedAt:  now,
+	}
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+// pickReviewers выбирает до n ревьюверов из candidates по стратегии сервиса
+func (s *ServiceImpl) pickReviewers(ctx context.Context, candidates []string, n int) ([]string, error) {
+	load, err := s.reviewLoad(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return pickByLoad(candidates, n, load), nil
+}
+
+// reviewLoad — открытые ревью кандидатов; нужен только для least_loaded, для random — nil
+func (s *ServiceImpl) reviewLoad(ctx context.Context, ids []string) (map[string]int, error) {
+	if s.strategy != entity.StrategyLeastLoaded || len(ids) == 0 {
+		return nil, nil
+	}
+	return s.prs.CountOpenReviews(ctx, ids)
+}
+
+// pickByLoad перемешивает кандидатов и, если load задан, устойчиво сортирует по нагрузке:
+// среди одинаково загруженных выбор остаётся случайным
+func pickByLoad(candidates []string, n int, load map[string]int) []string {
+	shuffled := slices.Clone(candidates)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	if load != nil {
+		slices.SortStableFunc(shuffled, func(a, b string) int { return load[a] - load[b] })
+	}
+	return shuffled[:min(n, len(shuffled))]
+}
+
+// planReplacements подбирает замену каждому деактивированному ревьюверу в prs: активный участник
+// команды, не автор и не второй ревьювер того же PR, а если таких нет — по правилам эскалации esc.
+// load учитывает уже сделанные назначения, чтобы least_loaded распределял и внутри одной деактивации.
+// Без кандидатов ревьювер снимается.
+func planReplacements(prs []entity.PullRequest, reviewers map[string][]string, deactivated, active []string, esc escalation, load map[string]int) []entity.ReviewerChange {
+	gone := make(map[string]bool, len(deactivated))
+	for _, id := range deactivated {
+		gone[id] = true
+	}
+
+	var changes []entity.ReviewerChange
+	for _, pr := range prs {
+		current := reviewers[pr.ID]
+		for _, oldID := range current {
+			if !gone[oldID] {
+				continue
+			}
+			excluded := func(id string) bool {
+				return id == pr.AuthorID || gone[id] || slices.Contains(current, id)
+			}
+			candidates := slices.DeleteFunc(slices.Clone(active), excluded)
+			if len(candidates) == 0 {
+				candidates, _ = esc.candidates(excluded)
+			}
+			change := entity.ReviewerChange{PRID: pr.ID, OldReviewerID: oldID}
+			if len(candidates) > 0 {
+				change.NewReviewerID = pickByLoad(candidates, 1, load)[0]
+				current = append(slices.Clone(current), change.NewReviewerID)
+				if load != nil {
+					load[change.NewReviewerID]++
+				}
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func idsOf(users []entity.User) []string {
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
@@ -5,7 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"math/rand"
+	"log/slog"
 	"slices"
 	"time"
 
@@ -21,20 +21,69 @@ type ServiceImpl struct {
 	teams     repository.TeamRepository
 	users     repository.UserRepository
 	prs       repository.PullRequestRepository
+	events    repository.EventRepository
+	stats     repository.StatsRepository
 	txManager repository.TxManager
+	bus       usecase.EventBus
+	metrics   usecase.Metrics
+
+	reviewersPerPR int
+	strategy       entity.ReviewerStrategy
+	defaultSLA     entity.SLA
+	notifyDefaults []entity.NotificationChannel
+}
+
+// Сколько ревьюверов назначается на PR, если Options.ReviewersPerPR не задан
+const DefaultReviewersPerPR = 2
+
+// Options — настройки назначения ревьюверов и SLA; нулевые значения — поведение по умолчанию
+type Options struct {
+	// ReviewersPerPR — сколько ревьюверов назначать на новый PR (0 — DefaultReviewersPerPR)
+	ReviewersPerPR int
+	// Strategy — как выбирать ревьюверов ("" — StrategyRandom)
+	Strategy entity.ReviewerStrategy
+	// DefaultSLA — SLA команд без собственного (RemindAfter 0 — DefaultRemindAfter)
+	DefaultSLA entity.SLA
+	// NotificationChannels — каналы уведомлений о назначениях для пользователей без своих настроек
+	NotificationChannels []entity.NotificationChannel
 }
 
+// Через сколько напоминать об открытом PR, если Options.DefaultSLA не задан
+const DefaultRemindAfter = 72 * time.Hour
+
 func NewService(
 	teams repository.TeamRepository,
 	users repository.UserRepository,
 	prs repository.PullRequestRepository,
+	events repository.EventRepository,
+	stats repository.StatsRepository,
 	txManager repository.TxManager,
+	bus usecase.EventBus,
+	metrics usecase.Metrics,
+	opts Options,
 ) usecase.Service {
+	if opts.ReviewersPerPR == 0 {
+		opts.ReviewersPerPR = DefaultReviewersPerPR
+	}
+	if opts.Strategy == "" {
+		opts.Strategy = entity.StrategyRandom
+	}
+	if opts.DefaultSLA.RemindAfter == 0 {
+		opts.DefaultSLA.RemindAfter = DefaultRemindAfter
+	}
 	return &ServiceImpl{
-		teams:     teams,
-		users:     users,
-		prs:       prs,
-		txManager: txManager,
+		teams:          teams,
+		users:          users,
+		prs:            prs,
+		events:         events,
+		stats:          stats,
+		txManager:      txManager,
+		bus:            bus,
+		metrics:        metrics,
+		reviewersPerPR: opts.ReviewersPerPR,
+		strategy:       opts.Strategy,
+		defaultSLA:     opts.DefaultSLA,
+		notifyDefaults: opts.NotificationChannels,
 	}
 }
 
@@ -91,6 +140,17 @@ func (s *ServiceImpl) AddOrUpdateTeam(ctx context.Context, team entity.Team) (en
 	return createdTeam.(entity.Team), nil
 }
 
+func (s *ServiceImpl) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	user, err := s.users.Get(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows || errors.Is(err, usecase.ErrUserNotFound) {
+			return entity.User{}, usecase.ErrUserNotFound
+		}
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
 func (s *ServiceImpl) SetUserActive(ctx context.Context, userID string, active bool) (entity.User, error) {
 	user, err := s.users.Get(ctx, userID)
 	if err != nil {
@@ -100,10 +160,13 @@ func (s *ServiceImpl) SetUserActive(ctx context.Context, userID string, active b
 		return entity.User{}, err
 	}
 
+	// Compare-and-swap по версии пользователя: параллельное изменение того же участника получает
+	// ErrVersionMismatch, а изменения разных участников одной команды друг другу не мешают
 	user.IsActive = active
-	if err := s.users.UpdateMany(ctx, []entity.User{user}); err != nil {
+	if err := s.users.Update(ctx, user); err != nil {
 		return entity.User{}, err
 	}
+	user.Version++
 
 	return user, nil
 }
@@ -146,7 +209,7 @@ func (s *ServiceImpl) GetUserStats(ctx context.Context, userID string) (entity.U
 
 // PullRequestUseCase methods
 
-func (s *ServiceImpl) CreatePR(ctx context.Context, id, name, authorID string) (entity.PullRequest, error) {
+func (s *ServiceImpl) CreatePR(ctx context.Context, id, name, authorID string, mode entity.NoCandidateMode) (entity.PullRequest, error) {
 	// Проверяем существование автора
 	author, err := s.users.Get(ctx, authorID)
 	if err != nil {
@@ -171,11 +234,29 @@ func (s *ServiceImpl) CreatePR(ctx context.Context, id, name, authorID string) (
 		return entity.PullRequest{}, err
 	}
 
-	// Случайный выбор до 2 ревьюверов
-	reviewerIDs := selectRandomReviewers(candidates, 2)
+	candidateIDs := idsOf(candidates)
+	var escalatedTo string
+	if len(candidateIDs) == 0 {
+		// В команде никого — по правилам эскалации команды автора
+		esc, err := s.escalation(ctx, author.TeamName)
+		if err != nil {
+			return entity.PullRequest{}, err
+		}
+		candidateIDs, escalatedTo = esc.candidates(func(id string) bool { return id == authorID })
+	}
+	if len(candidateIDs) == 0 && mode == entity.NoCandidateFail {
+		s.metrics.NoCandidates("create", 1)
+		return entity.PullRequest{}, usecase.ErrNoCandidates
+	}
+
+	reviewerIDs, err := s.pickReviewers(ctx, candidateIDs, s.reviewersPerPR)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
 
 	// Создаём PR и назначаем ревьюверов в транзакции
 	var pr entity.PullRequest
+	var events []entity.Event
 	createdPR, err := s.txManager.DoTx(ctx, func(txCtx context.Context) (any, error) {
 		now := time.Now()
 		pr = entity.PullRequest{
@@ -196,6 +277,16 @@ func (s *ServiceImpl) CreatePR(ctx context.Context, id, name, authorID string) (
 			}
 		}
 
+		pending := []entity.Event{newPREvent(entity.EventPRCreated, pr, author.TeamName, "")}
+		for _, rID := range reviewerIDs {
+			pending = append(pending, newPREvent(entity.EventReviewerAssigned, pr, author.TeamName, rID))
+		}
+		stored, err := s.events.Append(txCtx, pending)
+		if err != nil {
+			return nil, err
+		}
+		events = stored
+
 		// Получаем полный PR с ревьюверами
 		return s.prs.Get(txCtx, id)
 	})
@@ -203,11 +294,26 @@ func (s *ServiceImpl) CreatePR(ctx context.Context, id, name, authorID string) (
 	if err != nil {
 		return entity.PullRequest{}, err
 	}
+	s.publish(events)
+	s.metrics.PRCreated()
+	switch {
+	case len(reviewerIDs) == 0:
+		s.metrics.NoCandidates("create", 1)
+		slog.WarnContext(ctx, "no reviewer candidates for PR", "pr_id", id, "author_id", authorID)
+	case escalatedTo != "":
+		s.metrics.Escalated("create", escalatedTo, len(reviewerIDs))
+		slog.InfoContext(ctx, "reviewers assigned by escalation",
+			"pr_id", id, "author_id", authorID, "escalated_to", escalatedTo, "reviewers", reviewerIDs)
+	}
 
 	return createdPR.(entity.PullRequest), nil
 }
 
-func (s *ServiceImpl) MergePR(ctx context.Context, id string) (entity.PullRequest, error) {
+func (s *ServiceImpl) GetPR(ctx context.Context, id string) (entity.PullRequest, error) {
+	return s.prs.Get(ctx, id)
+}
+
+func (s *ServiceImpl) MergePR(ctx context.Context, id string, ifVersion int64) (entity.PullRequest, error) {
 	pr, err := s.prs.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, usecase.ErrPRNotFound) {
@@ -215,6 +321,9 @@ func (s *ServiceImpl) MergePR(ctx context.Context, id string) (entity.PullReques
 		}
 		return entity.PullRequest{}, err
 	}
+	if err := checkVersion(pr.Version, ifVersion); err != nil {
+		return entity.PullRequest{}, err
+	}
 
 	// Идемпотентность: если уже MERGED, возвращаем как есть
 	if pr.Status == entity.PRMerged {
@@ -226,12 +335,16 @@ func (s *ServiceImpl) MergePR(ctx context.Context, id string) (entity.PullReques
 		return pr, nil
 	}
 
+	var events []entity.Event
 	mergedPR, err := s.txManager.DoTx(ctx, func(txCtx context.Context) (any, error) {
 		// Перечитываем PR в транзакции (на случай, если статус изменился параллельно)
 		current, err := s.prs.Get(txCtx, id)
 		if err != nil {
 			return nil, err
 		}
+		if err := checkVersion(current.Version, ifVersion); err != nil {
+			return nil, err
+		}
 		if current.Status == entity.PRMerged {
 			// Уже кто-то успел смержить — идемпотентность
 			return current, nil
@@ -245,6 +358,16 @@ func (s *ServiceImpl) MergePR(ctx context.Context, id string) (entity.PullReques
 			return nil, err
 		}
 
+		teamName, err := s.authorTeam(txCtx, current.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		stored, err := s.events.Append(txCtx, []entity.Event{newPREvent(entity.EventPRMerged, current, teamName, "")})
+		if err != nil {
+			return nil, err
+		}
+		events = stored
+
 		// Перечитываем с ревьюверами уже в транзакции
 		finalPR, err := s.prs.Get(txCtx, id)
 		if err != nil {
@@ -261,12 +384,17 @@ func (s *ServiceImpl) MergePR(ctx context.Context, id string) (entity.PullReques
 	if err != nil {
 		return entity.PullRequest{}, err
 	}
+	s.publish(events)
+	// Событие есть только если PR смержен этим вызовом, а не параллельным
+	if len(events) > 0 {
+		s.metrics.PRMerged()
+	}
 	result := mergedPR.(entity.PullRequest)
 
 	return result, nil
 }
 
-func (s *ServiceImpl) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (entity.PullRequest, string, error) {
+func (s *ServiceImpl) ReassignReviewer(ctx context.Context, prID, oldReviewerID string, ifVersion int64, mode entity.NoCandidateMode) (entity.PullRequest, string, error) {
 	// Проверяем существование PR
 	pr, err := s.prs.Get(ctx, prID)
 	if err != nil {
@@ -276,21 +404,35 @@ func (s *ServiceImpl) ReassignReviewer(ctx context.Context, prID, oldReviewerID
 		return entity.PullRequest{}, "", err
 	}
 
+	if err := checkVersion(pr.Version, ifVersion); err != nil {
+		return entity.PullRequest{}, "", err
+	}
+
 	// Проверка: нельзя переназначать для MERGED PR
 	if pr.Status == entity.PRMerged {
 		return entity.PullRequest{}, "", usecase.ErrAlreadyMerged
 	}
 
 	// Выбираем нового user-a для ревью
+	var events []entity.Event
+	var escalatedTo string
 	result, err := s.txManager.DoTx(ctx, func(txCtx context.Context) (any, error) {
 		// 1. Перечитываем PR в транзакции
 		currentPR, err := s.prs.Get(txCtx, prID)
 		if err != nil {
 			return nil, err
 		}
+		if err := checkVersion(currentPR.Version, ifVersion); err != nil {
+			return nil, err
+		}
 		if currentPR.Status == entity.PRMerged {
 			return nil, usecase.ErrAlreadyMerged
 		}
+		// Увеличиваем версию первым делом: строка PR блокируется до конца транзакции,
+		// а параллельный reassign/merge с той же прочитанной версией получит ErrVersionMismatch
+		if err := s.prs.Update(txCtx, currentPR); err != nil {
+			return nil, err
+		}
 
 		// 2. Проверяем, что oldReviewerID всё ещё назначен
 		currentReviewers, err := s.prs.GetReviewers(txCtx, prID)
@@ -307,34 +449,60 @@ func (s *ServiceImpl) ReassignReviewer(ctx context.Context, prID, oldReviewerID
 			return nil, err
 		}
 
-		// 4. Получаем актуальных кандидатов из команды (исключаем автора PR и старого ревьювера)
+		// 4. Получаем актуальных кандидатов из команды (исключаем автора PR и уже назначенных,
+		// в том числе старого ревьювера)
 		candidates, err := s.users.GetActiveByTeam(txCtx, oldReviewer.TeamName, currentPR.AuthorID)
 		if err != nil {
 			return nil, err
 		}
+		assigned := func(id string) bool { return id == currentPR.AuthorID || slices.Contains(currentReviewers, id) }
+		candidateIDs := slices.DeleteFunc(idsOf(candidates), assigned)
 
-		var validCandidates []entity.User
-		for _, c := range candidates {
-			if c.ID != oldReviewerID {
-				validCandidates = append(validCandidates, c)
+		escalatedTo = ""
+		if len(candidateIDs) == 0 {
+			esc, err := s.escalation(txCtx, oldReviewer.TeamName)
+			if err != nil {
+				return nil, err
 			}
+			candidateIDs, escalatedTo = esc.candidates(assigned)
 		}
 
 		var newReviewerID string
 
-		if len(validCandidates) == 0 {
+		if len(candidateIDs) == 0 {
+			if mode == entity.NoCandidateFail {
+				// Откат транзакции: версия PR и ревьюверы не меняются
+				return nil, usecase.ErrNoCandidates
+			}
 			// Просто удаляем старого ревьювера
 			if err := s.prs.RemoveReviewer(txCtx, prID, oldReviewerID); err != nil {
 				return nil, err
 			}
 		} else {
-			// Выбираем нового — детерминированно внутри транзакции!
-			newReviewerID = selectRandomReviewers(validCandidates, 1)[0]
+			picked, err := s.pickReviewers(txCtx, candidateIDs, 1)
+			if err != nil {
+				return nil, err
+			}
+			newReviewerID = picked[0]
 			if err := s.prs.ReplaceReviewer(txCtx, prID, oldReviewerID, newReviewerID); err != nil {
 				return nil, err
 			}
 		}
 
+		teamName, err := s.authorTeam(txCtx, currentPR.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		pending := []entity.Event{newPREvent(entity.EventReviewerUnassigned, currentPR, teamName, oldReviewerID)}
+		if newReviewerID != "" {
+			pending = append(pending, newPREvent(entity.EventReviewerAssigned, currentPR, teamName, newReviewerID))
+		}
+		stored, err := s.events.Append(txCtx, pending)
+		if err != nil {
+			return nil, err
+		}
+		events = stored
+
 		// 5. Читаем финальный PR с актуальными ревьюверами — всё в транзакции!
 		finalPR, err := s.prs.Get(txCtx, prID)
 		if err != nil {
@@ -355,19 +523,40 @@ func (s *ServiceImpl) ReassignReviewer(ctx context.Context, prID, oldReviewerID
 		}, nil
 	})
 	if err != nil {
+		if errors.Is(err, usecase.ErrNoCandidates) {
+			s.metrics.NoCandidates("reassign", 1)
+		}
 		return entity.PullRequest{}, "", err
 	}
+	s.publish(events)
 
 	typedResult := result.(struct {
 		PR            entity.PullRequest
 		NewReviewerID string
 	})
 
+	if escalatedTo != "" && typedResult.NewReviewerID != "" {
+		s.metrics.Escalated("reassign", escalatedTo, 1)
+		slog.InfoContext(ctx, "reviewer reassigned by escalation", "pr_id", prID, "escalated_to", escalatedTo)
+	}
+	if typedResult.NewReviewerID != "" {
+		s.metrics.ReviewerReassigned("reassign", 1)
+		slog.InfoContext(ctx, "reviewer reassigned", "pr_id", prID, "old_reviewer_id", oldReviewerID, "new_reviewer_id", typedResult.NewReviewerID)
+	} else {
+		s.metrics.NoCandidates("reassign", 1)
+		slog.WarnContext(ctx, "reviewer removed without replacement", "pr_id", prID, "old_reviewer_id", oldReviewerID)
+	}
+
 	return typedResult.PR, typedResult.NewReviewerID, nil
 }
 
-func (s *ServiceImpl) GetPRStats(ctx context.Context) (entity.PRStats, error) {
-	stats, err := s.prs.GetStats(ctx)
+func (s *ServiceImpl) GetPRStats(ctx context.Context, filter entity.StatsFilter) (entity.PRStats, error) {
+	if filter.TeamName != "" {
+		if _, err := s.teams.Get(ctx, filter.TeamName); err != nil {
+			return entity.PRStats{}, err
+		}
+	}
+	stats, err := s.prs.GetStats(ctx, filter)
 	if err != nil {
 		return entity.PRStats{}, err
 	}
@@ -375,7 +564,7 @@ func (s *ServiceImpl) GetPRStats(ctx context.Context) (entity.PRStats, error) {
 }
 
 // Массовая деактивация с переназначением
-func (s *ServiceImpl) DeactivateUsersAndReassign(ctx context.Context, teamName string, userIDs []string) error {
+func (s *ServiceImpl) DeactivateUsersAndReassign(ctx context.Context, teamName string, userIDs []string, ifVersion int64) error {
 	if len(userIDs) == 0 {
 		return nil
 	}
@@ -383,12 +572,16 @@ func (s *ServiceImpl) DeactivateUsersAndReassign(ctx context.Context, teamName s
 	// === 1. Предварительные проверки вне транзакции ===
 
 	// Проверяем существование команды
-	if _, err := s.teams.Get(ctx, teamName); err != nil {
+	team, err := s.teams.Get(ctx, teamName)
+	if err != nil {
 		if errors.Is(err, usecase.ErrTeamNotFound) {
 			return usecase.ErrTeamNotFound
 		}
 		return err
 	}
+	if err := checkVersion(team.Version, ifVersion); err != nil {
+		return err
+	}
 
 	// Проверяем, что ВСЕ userIDs принадлежат этой команде
 	usersInTeam, err := s.users.GetByTeam(ctx, teamName)
@@ -408,119 +601,182 @@ func (s *ServiceImpl) DeactivateUsersAndReassign(ctx context.Context, teamName s
 	}
 
 	// === 2. Атомарная операция в транзакции ===
-	return s.txManager.Do(ctx, func(txCtx context.Context) error {
+	var events []entity.Event
+	var replaced, unfilled int
+	var escalated map[string]int
+	err = s.txManager.Do(ctx, func(txCtx context.Context) error {
+		replaced, unfilled = 0, 0
+		escalated = map[string]int{}
+
+		// 0. Версия команды: If-Match и защита от параллельного изменения состава
+		current, err := s.teams.Get(txCtx, teamName)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(current.Version, ifVersion); err != nil {
+			return err
+		}
+		if err := s.teams.Update(txCtx, current); err != nil {
+			return err
+		}
+
 		// 1. Деактивируем
 		if err := s.users.DeactivateMany(txCtx, userIDs); err != nil {
 			return err
 		}
 
-		// 2. Берём все открытые PR команды
-		teamOpenPRs, err := s.prs.GetOpenPRsByTeam(txCtx, teamName)
+		// 2. Открытые PR, где ревьюят деактивированные, — по ревьюверу, а не по команде автора: после
+		// эскалации (лид, команда эскалации) и фолбэка на родительскую команду участник ревьюит и чужие PR,
+		// и выборка по команде оставила бы там неактивного ревьювера. PR команды, где деактивированные
+		// не ревьюят, менять нечего. PR самих деактивированных авторов попадают сюда же
+		affected, err := s.prs.GetOpenPRsByReviewers(txCtx, userIDs)
 		if err != nil {
 			return err
 		}
-		if len(teamOpenPRs) == 0 {
+		if len(affected) == 0 {
 			return nil
 		}
 
-		// 3. все ревьюверы для этих PR
-		prIDs := make([]string, 0, len(teamOpenPRs))
-		prByID := make(map[string]entity.PullRequest, len(teamOpenPRs))
-		for _, pr := range teamOpenPRs {
-			prIDs = append(prIDs, pr.ID)
-			prByID[pr.ID] = pr
+		prIDs := make([]string, len(affected))
+		authorIDs := make([]string, len(affected))
+		for i, pr := range affected {
+			prIDs[i] = pr.ID
+			authorIDs[i] = pr.AuthorID
 		}
-
 		allReviewers, err := s.prs.GetReviewersBatch(txCtx, prIDs)
 		if err != nil {
 			return err
 		}
+		// События помечаются командой автора, как при создании и reassign: по ней фильтруется поток
+		// и считаются дневные агрегаты, а автор PR не обязательно из деактивируемой команды
+		authors, err := s.users.GetMany(txCtx, authorIDs)
+		if err != nil {
+			return err
+		}
+		authorTeam := make(map[string]string, len(authors))
+		for _, u := range authors {
+			authorTeam[u.ID] = u.TeamName
+		}
 
-		// 4. Активные пользователи команды
+		// 3. Активные пользователи команды (деактивированные уже исключены)
 		activeTeamUsers, err := s.users.GetActiveByTeam(txCtx, teamName, "")
 		if err != nil {
 			return err
 		}
-
-		activeUserIDs := make(map[string]bool, len(activeTeamUsers))
-		for _, u := range activeTeamUsers {
-			activeUserIDs[u.ID] = true
+		// Эскалация — уже после деактивации: снятый лид в кандидаты не попадёт
+		esc, err := s.escalation(txCtx, teamName)
+		if err != nil {
+			return err
 		}
-
-		deactivatedSet := make(map[string]bool, len(userIDs))
-		for _, id := range userIDs {
-			deactivatedSet[id] = true
+		active := idsOf(activeTeamUsers)
+		load, err := s.reviewLoad(txCtx, slices.Concat(active, esc.all()))
+		if err != nil {
+			return err
 		}
 
-		// 5. Обрабатываем каждый PR
-		for prID, pr := range prByID {
-			currentReviewers := allReviewers[prID]
-			var toReplace []string
+		// 4. Замены считаем в памяти и применяем пачкой: число запросов не зависит от числа PR
+		changes := planReplacements(affected, allReviewers, userIDs, active, esc, load)
+		if err := s.prs.BumpVersions(txCtx, affected); err != nil {
+			return err
+		}
+		if err := s.prs.ApplyReviewerChanges(txCtx, changes); err != nil {
+			return err
+		}
 
-			for _, rID := range currentReviewers {
-				if deactivatedSet[rID] {
-					toReplace = append(toReplace, rID)
-				}
-			}
-			if len(toReplace) == 0 {
+		prByID := make(map[string]entity.PullRequest, len(affected))
+		for _, pr := range affected {
+			prByID[pr.ID] = pr
+		}
+		pending := make([]entity.Event, 0, 2*len(changes))
+		for _, c := range changes {
+			pr := prByID[c.PRID]
+			prTeam := authorTeam[pr.AuthorID]
+			pending = append(pending, newPREvent(entity.EventReviewerUnassigned, pr, prTeam, c.OldReviewerID))
+			if c.NewReviewerID == "" {
+				unfilled++
 				continue
 			}
-
-			// Кандидаты: активные из команды, не автор и не уже назначены
-			var candidates []string
-			for _, u := range activeTeamUsers {
-				if u.ID != pr.AuthorID && !contains(currentReviewers, u.ID) {
-					candidates = append(candidates, u.ID)
-				}
-			}
-
-			for _, oldID := range toReplace {
-				if len(candidates) > 0 {
-					idx := rand.Intn(len(candidates))
-					newID := candidates[idx]
-					candidates[idx] = candidates[len(candidates)-1]
-					candidates = candidates[:len(candidates)-1]
-
-					if err := s.prs.ReplaceReviewer(txCtx, prID, oldID, newID); err != nil {
-						return err
-					}
-				} else {
-					if err := s.prs.RemoveReviewer(txCtx, prID, oldID); err != nil {
-						return err
-					}
-				}
+			pending = append(pending, newPREvent(entity.EventReviewerAssigned, pr, prTeam, c.NewReviewerID))
+			replaced++
+			if !slices.Contains(active, c.NewReviewerID) {
+				escalated[esc.targetOf(c.NewReviewerID)]++
 			}
 		}
 
+		stored, err := s.events.Append(txCtx, pending)
+		if err != nil {
+			return err
+		}
+		events = stored
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	s.publish(events)
+	s.metrics.ReviewerReassigned("deactivation", replaced)
+	s.metrics.NoCandidates("deactivation", unfilled)
+	for target, n := range escalated {
+		s.metrics.Escalated("deactivation", target, n)
+	}
+	slog.InfoContext(ctx, "team members deactivated",
+		"team", teamName, "users", len(userIDs), "reassigned", replaced, "unfilled", unfilled)
+
+	return nil
 }
 
-func contains(slice []string, val string) bool {
-	for _, v := range slice {
-		if v == val {
-			return true
-		}
+// EventUseCase methods
+
+func (s *ServiceImpl) ListEvents(ctx context.Context, afterID int64, filter entity.EventFilter, limit int) ([]entity.Event, error) {
+	return s.events.ListAfter(ctx, afterID, filter, limit)
+}
+
+func (s *ServiceImpl) SubscribeEvents(filter entity.EventFilter) (<-chan entity.Event, func()) {
+	return s.bus.Subscribe(filter)
+}
+
+// checkVersion сравнивает версию ресурса с ожидаемой из If-Match (0 — без проверки)
+func checkVersion(current, ifVersion int64) error {
+	if ifVersion != 0 && current != ifVersion {
+		return usecase.ErrVersionMismatch
 	}
-	return false
+	return nil
 }
 
-// selectRandomReviewers выбирает случайных ревьюверов из списка кандидатов (до maxCount)
-func selectRandomReviewers(candidates []entity.User, maxCount int) []string {
-	n := len(candidates)
-	if n == 0 {
-		return []string{}
+// publish отправляет закоммиченные события подписчикам
+func (s *ServiceImpl) publish(events []entity.Event) {
+	if len(events) > 0 {
+		s.bus.Publish(events...)
 	}
-	if maxCount > n {
-		maxCount = n
+}
+
+// authorTeam — команда автора PR, по ней фильтруются события
+func (s *ServiceImpl) authorTeam(ctx context.Context, authorID string) (string, error) {
+	author, err := s.users.Get(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return "", nil
+		}
+		return "", err
 	}
+	return author.TeamName, nil
+}
 
-	// rand.Perm возвращает случайную перестановку 0..n-1
-	perm := rand.Perm(n)
+func newPREvent(t entity.EventType, pr entity.PullRequest, teamName, reviewerID string) entity.Event {
+	return entity.Event{
+		Type:       t,
+		PRID:       pr.ID,
+		AuthorID:   pr.AuthorID,
+		TeamName:   teamName,
+		ReviewerID: reviewerID,
+	}
+}
 
-	result := make([]string, 0, maxCount)
-	for i := 0; i < maxCount; i++ {
-		result = append(result, candidates[perm[i]].ID)
+func contains(slice []string, val string) bool {
+	for _, v := range slice {
+		if v == val {
+			return true
+		}
 	}
-	return result
+	return false
 }
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+func (s *ServiceImpl) GetTeamSLA(ctx context.Context, teamName string) (entity.TeamSLA, error) {
+	if _, err := s.teams.Get(ctx, teamName); err != nil {
+		return entity.TeamSLA{}, err
+	}
+	sla, ok, err := s.teams.GetSLA(ctx, teamName)
+	if err != nil {
+		return entity.TeamSLA{}, err
+	}
+	if !ok {
+		return entity.TeamSLA{TeamName: teamName, SLA: s.defaultSLA, Default: true}, nil
+	}
+	return entity.TeamSLA{TeamName: teamName, SLA: sla}, nil
+}
+
+func (s *ServiceImpl) SetTeamSLA(ctx context.Context, teamName string, sla entity.SLA) (entity.TeamSLA, error) {
+	if sla.RemindAfter <= 0 || sla.ReassignAfter < 0 {
+		return entity.TeamSLA{}, fmt.Errorf("invalid SLA: remind after %s, reassign after %s", sla.RemindAfter, sla.ReassignAfter)
+	}
+	if _, err := s.teams.Get(ctx, teamName); err != nil {
+		return entity.TeamSLA{}, err
+	}
+	if err := s.teams.SaveSLA(ctx, teamName, sla); err != nil {
+		return entity.TeamSLA{}, err
+	}
+	return entity.TeamSLA{TeamName: teamName, SLA: sla}, nil
+}
+
+// StaleScanner ищет открытые PR, вышедшие за SLA команды: заменяет ревьюверов, которые держат PR
+// дольше ReassignAfter, и напоминает ревьюверам (а если их нет — автору) о PR старше RemindAfter.
+// Несколько реплик не должны сканировать одновременно — это обеспечивает вызывающий (см. pg.LeaderLock).
+type StaleScanner struct {
+	prs        repository.PullRequestRepository
+	reviews    usecase.PullRequestUseCase
+	notifier   usecase.Notifier
+	defaultSLA entity.SLA
+}
+
+// NewStaleScanner — reviews выполняет замены той же логикой, что и /pullRequest/reassign
+func NewStaleScanner(
+	prs repository.PullRequestRepository,
+	reviews usecase.PullRequestUseCase,
+	notifier usecase.Notifier,
+	defaultSLA entity.SLA,
+) *StaleScanner {
+	if defaultSLA.RemindAfter == 0 {
+		defaultSLA.RemindAfter = DefaultRemindAfter
+	}
+	return &StaleScanner{prs: prs, reviews: reviews, notifier: notifier, defaultSLA: defaultSLA}
+}
+
+// Scan — один проход на момент now. Неудавшиеся замены и напоминания не прерывают проход:
+// они считаются в Failed и повторяются на следующем.
+func (s *StaleScanner) Scan(ctx context.Context, now time.Time) (entity.StaleReport, error) {
+	var report entity.StaleReport
+
+	overdue, err := s.prs.ListOverdueReviews(ctx, now, s.defaultSLA)
+	if err != nil {
+		return report, err
+	}
+	for _, r := range overdue {
+		// Заменить некем — лучше оставить ревьювера, чем снять его и оставить PR совсем без ревью
+		_, replacedBy, err := s.reviews.ReassignReviewer(ctx, r.PRID, r.ReviewerID, 0, entity.NoCandidateFail)
+		switch {
+		// PR смержили или ревьювера заменили между выборкой и заменой — делать нечего
+		case errors.Is(err, usecase.ErrAlreadyMerged), errors.Is(err, usecase.ErrNotReviewer),
+			errors.Is(err, usecase.ErrPRNotFound), errors.Is(err, usecase.ErrVersionMismatch):
+			continue
+		case errors.Is(err, usecase.ErrNoCandidates):
+			slog.DebugContext(ctx, "no replacement for overdue reviewer", "pr_id", r.PRID, "reviewer_id", r.ReviewerID)
+			continue
+		case err != nil:
+			slog.WarnContext(ctx, "failed to reassign overdue reviewer",
+				"pr_id", r.PRID, "reviewer_id", r.ReviewerID, "error", err)
+			report.Failed++
+			continue
+		}
+		slog.InfoContext(ctx, "overdue reviewer reassigned",
+			"pr_id", r.PRID, "reviewer_id", r.ReviewerID, "replaced_by", replacedBy, "assigned_at", r.AssignedAt)
+		report.Reassigned++
+	}
+
+	// Выборка после замен: напоминание уходит уже новым ревьюверам
+	stale, err := s.prs.ListStale(ctx, now, s.defaultSLA)
+	if err != nil {
+		return report, err
+	}
+	reminded := make([]string, 0, len(stale))
+	for _, pr := range stale {
+		if err := s.notifier.Notify(ctx, staleNotification(pr, now)); err != nil {
+			slog.WarnContext(ctx, "failed to send stale PR reminder", "pr_id", pr.ID, "error", err)
+			report.Failed++
+			continue
+		}
+		reminded = append(reminded, pr.ID)
+	}
+	if err := s.prs.MarkReminded(ctx, reminded, now); err != nil {
+		return report, err
+	}
+	report.Reminded = len(reminded)
+	return report, nil
+}
+
+func staleNotification(pr entity.StalePR, now time.Time) entity.Notification {
+	recipients := pr.Reviewers
+	if len(recipients) == 0 {
+		recipients = []string{pr.AuthorID}
+	}
+	return entity.Notification{
+		Kind:       entity.NotificationStalePR,
+		PRID:       pr.ID,
+		PRName:     pr.Name,
+		AuthorID:   pr.AuthorID,
+		TeamName:   pr.TeamName,
+		Recipients: recipients,
+		Subject:    fmt.Sprintf("Pull request %s is waiting for review", pr.ID),
+		Text: fmt.Sprintf("Pull request %s (%s) by %s has been open for %s, longer than the team SLA of %s.",
+			pr.ID, pr.Name, pr.AuthorID, hours(now.Sub(*pr.CreatedAt)), hours(pr.SLA.RemindAfter)),
+		CreatedAt: now,
+	}
+}
+
+// hours — длительность целыми часами: в напоминании минуты и секунды только мешают
+func hours(d time.Duration) string {
+	return fmt.Sprintf("%dh", int64(d.Hours()))
+}
@@ -0,0 +1,160 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+func (s *ServiceImpl) GetTeamStats(ctx context.Context, teamName string, subteams bool) (entity.TeamStats, error) {
+	if _, err := s.teams.Get(ctx, teamName); err != nil {
+		return entity.TeamStats{}, err
+	}
+
+	prs, err := s.prs.GetStats(ctx, entity.StatsFilter{TeamName: teamName, Subteams: subteams})
+	if err != nil {
+		return entity.TeamStats{}, err
+	}
+	members, err := s.teams.ReviewLoad(ctx, teamName, subteams)
+	if err != nil {
+		return entity.TeamStats{}, err
+	}
+	understaffed, err := s.teams.Understaffed(ctx, teamName, subteams, s.reviewersPerPR)
+	if err != nil {
+		return entity.TeamStats{}, err
+	}
+
+	// Неактивные не получают новых ревью — в распределении их не учитываем
+	var active []int
+	for _, m := range members {
+		if m.IsActive {
+			active = append(active, m.OpenReviews)
+		}
+	}
+
+	return entity.TeamStats{
+		TeamName:          teamName,
+		WithSubteams:      subteams,
+		PRs:               prs,
+		Members:           members,
+		LoadGini:          gini(active),
+		RequiredReviewers: s.reviewersPerPR,
+		Understaffed:      understaffed,
+	}, nil
+}
+
+// gini — коэффициент Джини по формуле для упорядоченной выборки:
+// G = 2·Σ i·x(i) / (n·Σ x) − (n+1)/n, i с единицы. Без ревью вовсе — 0.
+func gini(values []int) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += float64(v)
+		weighted += float64(i+1) * float64(v)
+	}
+	if sum == 0 {
+		return 0
+	}
+	n := float64(len(sorted))
+	// При равной нагрузке погрешность округления может дать −1e-16
+	return max(0, 2*weighted/(n*sum)-(n+1)/n)
+}
+
+func (s *ServiceImpl) RollupStats(ctx context.Context, from, to time.Time) (int, error) {
+	res, err := s.txManager.DoTx(ctx, func(txCtx context.Context) (any, error) {
+		if from.IsZero() {
+			start, ok, err := s.stats.RollupStart(txCtx)
+			if err != nil || !ok {
+				return 0, err
+			}
+			from = start
+		}
+		return s.stats.Rollup(txCtx, from, to)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.(int), nil
+}
+
+func (s *ServiceImpl) GetTimeseries(ctx context.Context, filter entity.TimeseriesFilter) ([]entity.TimeseriesPoint, error) {
+	switch {
+	case filter.UserID != "":
+		if _, err := s.users.Get(ctx, filter.UserID); err != nil {
+			if err == sql.ErrNoRows || errors.Is(err, usecase.ErrUserNotFound) {
+				return nil, usecase.ErrUserNotFound
+			}
+			return nil, err
+		}
+	case filter.TeamName != "":
+		if _, err := s.teams.Get(ctx, filter.TeamName); err != nil {
+			return nil, err
+		}
+	}
+	if filter.Granularity == "" {
+		filter.Granularity = entity.GranularityDay
+	}
+	return s.stats.Timeseries(ctx, filter)
+}
+
+func (s *ServiceImpl) GetLeaderboard(ctx context.Context, filter entity.LeaderboardFilter) ([]entity.LeaderboardEntry, error) {
+	if filter.TeamName != "" {
+		if _, err := s.teams.Get(ctx, filter.TeamName); err != nil {
+			return nil, err
+		}
+	}
+	entries, err := s.stats.Leaderboard(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		e := &entries[i]
+		if ended := e.ReassignedAway + e.ReviewsCompleted; ended > 0 {
+			rate := float64(e.ReassignedAway) / float64(ended)
+			e.ReassignAwayRate = &rate
+		}
+	}
+	// Стабильная сортировка: при равенстве остаётся порядок репозитория (по user_id)
+	slices.SortStableFunc(entries, leaderboardOrder(filter.SortBy))
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+	return entries, nil
+}
+
+func leaderboardOrder(by entity.LeaderboardSort) func(a, b entity.LeaderboardEntry) int {
+	switch by {
+	case entity.LeaderboardByOpen:
+		return func(a, b entity.LeaderboardEntry) int { return cmp.Compare(b.OpenReviews, a.OpenReviews) }
+	case entity.LeaderboardByTurnaround:
+		return func(a, b entity.LeaderboardEntry) int {
+			return compareNilLast(a.AvgTurnaroundHours, b.AvgTurnaroundHours)
+		}
+	case entity.LeaderboardByReassignRate:
+		return func(a, b entity.LeaderboardEntry) int { return compareNilLast(a.ReassignAwayRate, b.ReassignAwayRate) }
+	default:
+		return func(a, b entity.LeaderboardEntry) int { return cmp.Compare(b.ReviewsCompleted, a.ReviewsCompleted) }
+	}
+}
+
+// compareNilLast — по возрастанию, отсутствующие значения в конце
+func compareNilLast(a, b *float64) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return cmp.Compare(*a, *b)
+}
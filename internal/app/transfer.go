@@ -0,0 +1,406 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+// Размер страницы PR при выгрузке: ревьюверы догружаются одним запросом на страницу
+const exportPageSize = 500
+
+// Размер пачки импорта: столько записей проверяется и пишется за раз, в памяти файл целиком не держится
+const importBatchSize = 500
+
+// Export читает всё в одной транзакции REPEATABLE READ: команда или PR, добавленные во время выгрузки,
+// либо попадут в неё вместе с авторами, либо не попадут вовсе
+func (s *ServiceImpl) Export(ctx context.Context, emit func(entity.TransferRecord) error) error {
+	return s.txManager.DoSnapshot(ctx, func(ctx context.Context) error {
+		return s.export(ctx, emit)
+	})
+}
+
+func (s *ServiceImpl) export(ctx context.Context, emit func(entity.TransferRecord) error) error {
+	teams, err := s.teams.List(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range teams {
+		if err := emit(entity.TransferRecord{Team: &teams[i]}); err != nil {
+			return err
+		}
+	}
+
+	afterID := ""
+	for {
+		page, err := s.prs.List(ctx, afterID, exportPageSize)
+		if err != nil {
+			return err
+		}
+		if len(page) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(page))
+		for i, pr := range page {
+			ids[i] = pr.ID
+		}
+		reviewers, err := s.prs.GetReviewersBatch(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for i := range page {
+			page[i].Reviewers = reviewers[page[i].ID]
+			if err := emit(entity.TransferRecord{PullRequest: &page[i]}); err != nil {
+				return err
+			}
+		}
+
+		if len(page) < exportPageSize {
+			return nil
+		}
+		afterID = page[len(page)-1].ID
+	}
+}
+
+// Import проверяет и сразу пишет каждую пачку. После первого конфликта запись прекращается, но файл
+// дочитывается, чтобы собрать все конфликты, а транзакция откатывается вместе с уже записанными пачками.
+// Записанные пачки не меняют решений по следующим: повтор id в файле — конфликт раньше, чем обращение к БД.
+func (s *ServiceImpl) Import(ctx context.Context, src usecase.RecordReader, dryRun bool) (entity.ImportResult, error) {
+	plan := newImportPlan()
+	err := s.txManager.Do(ctx, func(txCtx context.Context) error {
+		for {
+			batch, err := readBatch(src, importBatchSize)
+			if err != nil {
+				return fmt.Errorf("%w: %w", usecase.ErrInvalidImport, err)
+			}
+			if len(batch) == 0 {
+				break
+			}
+			if err := s.planImport(txCtx, plan, batch); err != nil {
+				return err
+			}
+			steps := plan.steps
+			plan.steps = nil
+			if dryRun || len(plan.result.Conflicts) > 0 {
+				continue
+			}
+			for _, step := range steps {
+				if err := step(txCtx); err != nil {
+					return err
+				}
+			}
+		}
+		if len(plan.result.Conflicts) > 0 && !dryRun {
+			return fmt.Errorf("%w: %d conflicting records", usecase.ErrImportConflict, len(plan.result.Conflicts))
+		}
+		return nil
+	})
+
+	result := plan.result
+	result.DryRun = dryRun
+	if errors.Is(err, usecase.ErrImportConflict) {
+		return result, err
+	}
+	if err != nil {
+		return entity.ImportResult{}, err
+	}
+	return result, nil
+}
+
+// readBatch — до size записей; пустая пачка — файл кончился
+func readBatch(src usecase.RecordReader, size int) ([]entity.TransferRecord, error) {
+	batch := make([]entity.TransferRecord, 0, size)
+	for len(batch) < size {
+		rec, err := src.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, rec)
+	}
+	return batch, nil
+}
+
+// importPlan — итог проверки файла: счётчики, конфликты и шаги записи для бесконфликтных записей текущей пачки
+type importPlan struct {
+	result entity.ImportResult
+	steps  []func(ctx context.Context) error
+	// read — сколько записей уже прочитано: номер строки для записей без Line
+	read int
+
+	// Что уже встретилось в файле: дубликаты — конфликт, а PR могут ссылаться на пользователей из файла
+	teams map[string]bool
+	users map[string]string
+	prs   map[string]bool
+}
+
+func newImportPlan() *importPlan {
+	return &importPlan{
+		teams: make(map[string]bool),
+		users: make(map[string]string),
+		prs:   make(map[string]bool),
+	}
+}
+
+// planImport читает текущее состояние и решает по каждой записи пачки, что с ней будет; ничего не пишет
+func (s *ServiceImpl) planImport(ctx context.Context, plan *importPlan, records []entity.TransferRecord) error {
+	for _, rec := range records {
+		plan.read++
+		line := rec.Line
+		if line == 0 {
+			line = plan.read
+		}
+
+		var err error
+		switch {
+		case rec.Team != nil && rec.PullRequest == nil:
+			err = s.planTeam(ctx, plan, line, *rec.Team)
+		case rec.PullRequest != nil && rec.Team == nil:
+			err = s.planPR(ctx, plan, line, *rec.PullRequest)
+		default:
+			plan.conflict(line, "", "", "expected either a team or a pull request")
+		}
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return nil
+}
+
+func (p *importPlan) conflict(line int, kind, id, reason string) {
+	p.result.Conflicts = append(p.result.Conflicts, entity.ImportConflict{Line: line, Kind: kind, ID: id, Reason: reason})
+}
+
+// planTeam: новая команда создаётся, в существующую дописываются участники; тех, кого нет в файле, не удаляем.
+// Переход пользователя из другой команды — конфликт: импорт не меняет состав чужих команд.
+func (s *ServiceImpl) planTeam(ctx context.Context, plan *importPlan, line int, team entity.Team) error {
+	conflicts := len(plan.result.Conflicts)
+	if team.Name == "" {
+		plan.conflict(line, entity.ConflictTeam, "", "team name is required")
+		return nil
+	}
+	if plan.teams[team.Name] {
+		plan.conflict(line, entity.ConflictTeam, team.Name, "team appears in the file more than once")
+		return nil
+	}
+	plan.teams[team.Name] = true
+
+	existing, err := s.teams.Get(ctx, team.Name)
+	isNew := errors.Is(err, usecase.ErrTeamNotFound)
+	if err != nil && !isNew {
+		return err
+	}
+	current := make(map[string]entity.User, len(existing.Members))
+	for _, u := range existing.Members {
+		current[u.ID] = u
+	}
+
+	var counts entity.ImportCounts
+	for i := range team.Members {
+		u := &team.Members[i]
+		u.TeamName = team.Name
+		if u.ID == "" {
+			plan.conflict(line, entity.ConflictUser, "", "team "+team.Name+": member without user_id")
+			continue
+		}
+		if other, ok := plan.users[u.ID]; ok {
+			plan.conflict(line, entity.ConflictUser, u.ID, "user is listed in teams "+other+" and "+team.Name)
+			continue
+		}
+		plan.users[u.ID] = team.Name
+
+		if cur, ok := current[u.ID]; ok {
+			if cur == *u {
+				counts.Unchanged++
+			} else {
+				counts.Updated++
+			}
+			continue
+		}
+		stored, err := s.users.Get(ctx, u.ID)
+		switch {
+		case errors.Is(err, usecase.ErrUserNotFound):
+			counts.Created++
+		case err != nil:
+			return err
+		default:
+			plan.conflict(line, entity.ConflictUser, u.ID, "user belongs to team "+stored.TeamName)
+		}
+	}
+	if len(plan.result.Conflicts) > conflicts {
+		return nil
+	}
+
+	users := &plan.result.Users
+	users.Created += counts.Created
+	users.Updated += counts.Updated
+	users.Unchanged += counts.Unchanged
+
+	switch {
+	case isNew:
+		plan.result.Teams.Created++
+		plan.steps = append(plan.steps, func(ctx context.Context) error {
+			if err := s.teams.Save(ctx, team); err != nil {
+				return err
+			}
+			return s.users.SaveUpdateMany(ctx, team.Members)
+		})
+	case counts.Created+counts.Updated > 0:
+		plan.result.Teams.Updated++
+		plan.steps = append(plan.steps, func(ctx context.Context) error {
+			if err := s.users.SaveUpdateMany(ctx, team.Members); err != nil {
+				return err
+			}
+			// Состав изменился — версия команды растёт, как при /team/add
+			return s.teams.Update(ctx, existing)
+		})
+	default:
+		plan.result.Teams.Unchanged++
+	}
+	return nil
+}
+
+// planPR: новый PR создаётся с ревьюверами из файла, без автоназначения. У существующего меняются только
+// имя и статус: автор и ревьюверы из файла должны совпадать с текущими, переоткрыть смерженный PR нельзя.
+func (s *ServiceImpl) planPR(ctx context.Context, plan *importPlan, line int, pr entity.PullRequest) error {
+	if err := validateImportedPR(&pr); err != nil {
+		plan.conflict(line, entity.ConflictPullRequest, pr.ID, err.Error())
+		return nil
+	}
+	if plan.prs[pr.ID] {
+		plan.conflict(line, entity.ConflictPullRequest, pr.ID, "pull request appears in the file more than once")
+		return nil
+	}
+	plan.prs[pr.ID] = true
+
+	conflicts := len(plan.result.Conflicts)
+	for _, id := range append([]string{pr.AuthorID}, pr.Reviewers...) {
+		if _, ok := plan.users[id]; ok {
+			continue
+		}
+		_, err := s.users.Get(ctx, id)
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			plan.conflict(line, entity.ConflictPullRequest, pr.ID, "user "+id+" not found")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	existing, err := s.prs.Get(ctx, pr.ID)
+	if errors.Is(err, usecase.ErrPRNotFound) {
+		if len(plan.result.Conflicts) > conflicts {
+			return nil
+		}
+		plan.result.PullRequests.Created++
+		plan.steps = append(plan.steps, func(ctx context.Context) error { return s.createImportedPR(ctx, pr) })
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.AuthorID != pr.AuthorID {
+		plan.conflict(line, entity.ConflictPullRequest, pr.ID, "author is "+existing.AuthorID+", import cannot change it")
+	}
+	if existing.Status == entity.PRMerged && pr.Status == entity.PROpen {
+		plan.conflict(line, entity.ConflictPullRequest, pr.ID, "pull request is already merged")
+	}
+	if !sameMembers(existing.Reviewers, pr.Reviewers) {
+		plan.conflict(line, entity.ConflictPullRequest, pr.ID,
+			fmt.Sprintf("reviewers are %v, import does not reassign them", existing.Reviewers))
+	}
+	if len(plan.result.Conflicts) > conflicts {
+		return nil
+	}
+
+	if existing.Name == pr.Name && existing.Status == pr.Status {
+		plan.result.PullRequests.Unchanged++
+		return nil
+	}
+	plan.result.PullRequests.Updated++
+	plan.steps = append(plan.steps, func(ctx context.Context) error {
+		existing.Name = pr.Name
+		if existing.Status != pr.Status {
+			existing.Status = pr.Status
+			existing.MergedAt = pr.MergedAt
+		}
+		return s.prs.Update(ctx, existing)
+	})
+	return nil
+}
+
+func (s *ServiceImpl) createImportedPR(ctx context.Context, pr entity.PullRequest) error {
+	// Сначала OPEN: назначения для MERGED PR запрещены триггером
+	open := pr
+	open.Status = entity.PROpen
+	open.MergedAt = nil
+	if err := s.prs.Save(ctx, open); err != nil {
+		return err
+	}
+	if len(pr.Reviewers) > 0 {
+		if err := s.prs.AssignReviewers(ctx, pr.ID, pr.Reviewers); err != nil {
+			return err
+		}
+	}
+	if pr.Status == entity.PRMerged {
+		return s.prs.Save(ctx, pr)
+	}
+	return nil
+}
+
+func validateImportedPR(pr *entity.PullRequest) error {
+	if pr.ID == "" || pr.Name == "" || pr.AuthorID == "" {
+		return errors.New("pull request id, name and author are required")
+	}
+
+	switch pr.Status {
+	case "":
+		pr.Status = entity.PROpen
+	case entity.PROpen, entity.PRMerged:
+	default:
+		return fmt.Errorf("unknown status %q", pr.Status)
+	}
+	if pr.Status == entity.PRMerged && pr.MergedAt == nil {
+		now := time.Now()
+		pr.MergedAt = &now
+	}
+	if pr.Status == entity.PROpen {
+		pr.MergedAt = nil
+	}
+
+	if len(pr.Reviewers) > 2 {
+		return errors.New("at most 2 reviewers")
+	}
+	for i, r := range pr.Reviewers {
+		if r == pr.AuthorID {
+			return errors.New("author cannot review own PR")
+		}
+		if contains(pr.Reviewers[:i], r) {
+			return errors.New("duplicate reviewer " + r)
+		}
+	}
+	return nil
+}
+
+// sameMembers — одинаковые множества id без учёта порядка
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package configs
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Load собирает конфигурацию по возрастанию приоритета: значения по умолчанию для окружения,
+// YAML-файл (-config или CONFIG_FILE), переменные окружения, флаги. args — аргументы до имени
+// команды; возвращаются оставшиеся. Ошибки разбора и проверки перечисляются все сразу.
+// usage печатается по -h перед списком флагов.
+func Load(args []string, usage string) (*Config, []string, error) {
+	leaves := fields(reflect.TypeOf(Config{}), "", nil)
+
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fmt.Fprintln(fs.Output(), "\nflags (перекрывают файл и переменные окружения):")
+		fs.PrintDefaults()
+	}
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML-файл конфигурации (CONFIG_FILE)")
+	// Флаги применяются последними, поэтому значения только запоминаем
+	var fromFlags []setting
+	for _, f := range leaves {
+		remember := func(v string) error {
+			fromFlags = append(fromFlags, setting{field: f, value: v, source: "-" + f.path})
+			return nil
+		}
+		if reflect.TypeOf(Config{}).FieldByIndex(f.index).Type.Kind() == reflect.Bool {
+			fs.BoolFunc(f.path, f.env, remember)
+			continue
+		}
+		fs.Func(f.path, f.env, remember)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	var file []byte
+	if *configFile != "" {
+		var err error
+		if file, err = os.ReadFile(*configFile); err != nil {
+			return nil, nil, fmt.Errorf("read config file: %w", err)
+		}
+	}
+
+	env, err := resolveEnv(file, fromFlags)
+	if err != nil {
+		return nil, nil, fmt.Errorf("config file %s: %w", *configFile, err)
+	}
+	cfg := Default(env)
+
+	if len(file) > 0 {
+		dec := yaml.NewDecoder(bytes.NewReader(file))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, fmt.Errorf("config file %s: %w", *configFile, err)
+		}
+	}
+
+	var errs []error
+	root := reflect.ValueOf(cfg).Elem()
+	for _, f := range leaves {
+		if v, ok := os.LookupEnv(f.env); ok && v != "" {
+			errs = append(errs, setting{field: f, value: v, source: f.env}.apply(root))
+		}
+	}
+	for _, s := range fromFlags {
+		errs = append(errs, s.apply(root))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return cfg, fs.Args(), nil
+}
+
+// resolveEnv нужен до остальных значений: от окружения зависят значения по умолчанию
+func resolveEnv(file []byte, fromFlags []setting) (string, error) {
+	env := ""
+	for _, s := range fromFlags {
+		if s.field.path == "env" {
+			env = s.value
+		}
+	}
+	if env == "" {
+		env = os.Getenv("APP_ENV")
+	}
+	if env == "" && len(file) > 0 {
+		var peek struct {
+			Env string `yaml:"env"`
+		}
+		if err := yaml.Unmarshal(file, &peek); err != nil {
+			return "", err
+		}
+		env = peek.Env
+	}
+	if env == "" {
+		env = "test"
+	}
+	return env, nil
+}
+
+// field — настройка-лист: путь из ключей YAML, переменная окружения и индекс поля в Config
+type field struct {
+	path   string
+	env    string
+	secret string
+	index  []int
+}
+
+func fields(t reflect.Type, prefix string, index []int) []field {
+	var out []field
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		path := prefix + sf.Tag.Get("yaml")
+		idx := append(append([]int{}, index...), i)
+		if sf.Type.Kind() == reflect.Struct {
+			out = append(out, fields(sf.Type, path+".", idx)...)
+			continue
+		}
+		out = append(out, field{path: path, env: sf.Tag.Get("env"), secret: sf.Tag.Get("secret"), index: idx})
+	}
+	return out
+}
+
+// setting — значение из переменной окружения или флага; source попадает в текст ошибки
+type setting struct {
+	field  field
+	value  string
+	source string
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func (s setting) apply(root reflect.Value) error {
+	v := root.FieldByIndex(s.field.index)
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s.value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid duration %q, expected e.g. 5s or 1m30s", s.source, s.value)
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(s.value)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s.value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q, expected true or false", s.source, s.value)
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Int:
+		n, err := strconv.Atoi(strings.TrimSpace(s.value))
+		if err != nil {
+			return fmt.Errorf("%s: invalid integer %q", s.source, s.value)
+		}
+		v.SetInt(int64(n))
+	case v.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(s.value, 64)
+		if err != nil {
+			return fmt.Errorf("%s: invalid number %q", s.source, s.value)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("%s: unsupported config type %s", s.source, v.Type())
+	}
+	return nil
+}
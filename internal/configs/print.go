@@ -0,0 +1,48 @@
+package configs
+
+import (
+	"io"
+	"net/url"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "***"
+
+// Print пишет итоговую конфигурацию в формате файла конфигурации; секреты скрыты
+func Print(w io.Writer, cfg *Config) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(cfg.Redacted()); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// Redacted — копия без секретов: ключи заменяются целиком, в DSN скрывается только пароль
+func (c *Config) Redacted() *Config {
+	out := *c
+	root := reflect.ValueOf(&out).Elem()
+	for _, f := range fields(reflect.TypeOf(out), "", nil) {
+		v := root.FieldByIndex(f.index)
+		if f.secret == "" || v.String() == "" {
+			continue
+		}
+		if f.secret == "url" {
+			v.SetString(redactURL(v.String()))
+			continue
+		}
+		v.SetString(redacted)
+	}
+	return &out
+}
+
+func redactURL(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil || u.Scheme == "" {
+		// DSN вида "host=... password=..." не разбираем — скрываем целиком
+		return redacted
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,163 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+)
+
+// Validate проверяет значения целиком и возвращает все нарушения сразу, по одному на строку
+func (c *Config) Validate() error {
+	v := validator{env: envNames()}
+
+	v.oneOf("env", c.Env, "prod", "test", "dev")
+	v.oneOf("log_level", c.LogLevel, "debug", "info", "warn", "error")
+	if !tenant.Valid(c.Tenant) {
+		v.fail("tenant", fmt.Sprintf("invalid tenant id %q: lowercase letters, digits, - and _", c.Tenant))
+	}
+
+	v.port("http.port", c.HTTP.Port)
+	v.nonNegative("http.read_header_timeout", c.HTTP.ReadHeaderTimeout)
+	v.nonNegative("http.read_timeout", c.HTTP.ReadTimeout)
+	v.nonNegative("http.write_timeout", c.HTTP.WriteTimeout)
+	v.nonNegative("http.idle_timeout", c.HTTP.IdleTimeout)
+	v.positive("http.shutdown_timeout", c.HTTP.ShutdownTimeout)
+	v.nonNegative("http.shutdown_delay", c.HTTP.ShutdownDelay)
+	v.positive("http.readiness_timeout", c.HTTP.ReadinessTimeout)
+
+	if c.Features.GRPC {
+		v.port("grpc.port", c.GRPC.Port)
+		if c.GRPC.Port == c.HTTP.Port {
+			v.fail("grpc.port", "must differ from http.port")
+		}
+	}
+
+	if c.Database.URL == "" {
+		v.fail("database.url", "is required")
+	}
+	if c.Database.MaxOpenConns < 0 {
+		v.fail("database.max_open_conns", "must be >= 0 (0 — без ограничения)")
+	}
+	if c.Database.MaxIdleConns < 0 {
+		v.fail("database.max_idle_conns", "must be >= 0")
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		v.fail("database.max_idle_conns", fmt.Sprintf("must not exceed database.max_open_conns (%d)", c.Database.MaxOpenConns))
+	}
+	v.nonNegative("database.conn_max_lifetime", c.Database.ConnMaxLifetime)
+	v.nonNegative("database.conn_max_idle_time", c.Database.ConnMaxIdleTime)
+
+	if c.Reviewers.PerPR < 1 || c.Reviewers.PerPR > 2 {
+		v.fail("reviewers.per_pr", "must be 1 or 2: the database allows at most 2 reviewers per PR")
+	}
+	v.oneOf("reviewers.strategy", string(c.Reviewers.Strategy),
+		string(entity.StrategyRandom), string(entity.StrategyLeastLoaded))
+
+	v.positive("idempotency.ttl", c.Idempotency.TTL)
+	v.positive("idempotency.lease", c.Idempotency.Lease)
+	if c.Idempotency.Lease > c.Idempotency.TTL {
+		v.fail("idempotency.lease", "must not exceed idempotency.ttl")
+	}
+	v.nonNegative("rollup.interval", c.Rollup.Interval)
+
+	v.nonNegative("stale.interval", c.Stale.Interval)
+	v.positive("stale.remind_after", c.Stale.RemindAfter)
+	v.nonNegative("stale.reassign_after", c.Stale.ReassignAfter)
+
+	v.oneOf("notify.channel", c.Notify.Channel, "log", "webhook", "smtp")
+	switch c.Notify.Channel {
+	case "webhook":
+		if c.Notify.WebhookURL == "" {
+			v.fail("notify.webhook_url", "is required for channel webhook")
+		}
+	case "smtp":
+		if c.Notify.SMTPAddr == "" {
+			v.fail("notify.smtp_addr", "is required for channel smtp")
+		}
+		if c.Notify.SMTPTo == "" {
+			v.fail("notify.smtp_to", "is required for channel smtp")
+		}
+	}
+	for _, ch := range SplitList(c.Notify.AssignmentChannels) {
+		v.oneOf("notify.assignment_channels", ch,
+			string(entity.ChannelEmail), string(entity.ChannelChat), string(entity.ChannelFile))
+		switch entity.NotificationChannel(ch) {
+		case entity.ChannelEmail:
+			if c.Notify.SMTPAddr == "" {
+				v.fail("notify.smtp_addr", "is required for assignment channel email")
+			}
+		case entity.ChannelChat:
+			if c.Notify.ChatWebhookURL == "" {
+				v.fail("notify.chat_webhook_url", "is required for assignment channel chat")
+			}
+		}
+	}
+
+	if !c.Auth.Enabled && c.Env == "prod" {
+		v.fail("auth.enabled", "cannot be disabled in prod")
+	}
+
+	v.oneOf("tracing.exporter", c.Tracing.Exporter, "none", "stdout", "file", "otlp")
+	if c.Tracing.Exporter == "file" && c.Tracing.File == "" {
+		v.fail("tracing.file", "is required for exporter file")
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		v.fail("tracing.sample_ratio", "must be between 0 and 1")
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return errors.New("invalid configuration:\n  - " + strings.Join(v.errs, "\n  - "))
+}
+
+type validator struct {
+	env  map[string]string
+	errs []string
+}
+
+// fail пишет путь вместе с переменной окружения: так понятнее, что именно исправлять
+func (v *validator) fail(path, msg string) {
+	if env := v.env[path]; env != "" {
+		path += " (" + env + ")"
+	}
+	v.errs = append(v.errs, path+": "+msg)
+}
+
+func (v *validator) oneOf(path, value string, allowed ...string) {
+	if !slices.Contains(allowed, value) {
+		v.fail(path, fmt.Sprintf("unknown value %q, expected one of: %s", value, strings.Join(allowed, ", ")))
+	}
+}
+
+func (v *validator) port(path string, port int) {
+	if port < 1 || port > 65535 {
+		v.fail(path, fmt.Sprintf("must be between 1 and 65535, got %d", port))
+	}
+}
+
+func (v *validator) positive(path string, d time.Duration) {
+	if d <= 0 {
+		v.fail(path, "must be positive")
+	}
+}
+
+func (v *validator) nonNegative(path string, d time.Duration) {
+	if d < 0 {
+		v.fail(path, "must not be negative")
+	}
+}
+
+func envNames() map[string]string {
+	names := make(map[string]string)
+	for _, f := range fields(reflect.TypeOf(Config{}), "", nil) {
+		names[f.path] = f.env
+	}
+	return names
+}
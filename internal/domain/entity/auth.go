@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleTeamLead Role = "team_lead"
+	RoleMember   Role = "member"
+	RoleReadOnly Role = "read_only"
+)
+
+func (r Role) Valid() bool {
+	switch r {
+	case RoleAdmin, RoleTeamLead, RoleMember, RoleReadOnly:
+		return true
+	}
+	return false
+}
+
+// Principal — аутентифицированный вызывающий.
+// TeamName обязателен для team_lead: его права ограничены своей командой.
+type Principal struct {
+	Subject  string
+	Role     Role
+	TeamName string
+	// TenantID — арендатор, в данных которого выполняется вызов
+	TenantID string
+	// Subteams — дочерние команды TeamName на всех уровнях: team_lead управляет и ими
+	Subteams []string
+}
+
+// APIKey — статический ключ доступа; в БД хранится только хеш.
+// Ключ действует только в данных своего арендатора (TenantID).
+type APIKey struct {
+	ID        int64
+	Name      string
+	Hash      string
+	Role      Role
+	TeamName  string
+	TenantID  string
+	CreatedAt time.Time
+}
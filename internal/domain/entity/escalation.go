@@ -0,0 +1,19 @@
+package entity
+
+// EscalationPolicy — к кому идти за ревьювером, когда в команде не осталось активных кандидатов:
+// сначала к лиду команды, затем к участникам команды эскалации. Пустое поле — шаг пропускается.
+type EscalationPolicy struct {
+	TeamName       string
+	LeadUserID     string
+	EscalationTeam string
+}
+
+// NoCandidateMode — что делать, если ревьювера не нашлось и после эскалации
+type NoCandidateMode string
+
+const (
+	// NoCandidateEmpty — оставить PR без ревьювера (поведение по умолчанию)
+	NoCandidateEmpty NoCandidateMode = "EMPTY"
+	// NoCandidateFail — вернуть usecase.ErrNoCandidates, ничего не меняя
+	NoCandidateFail NoCandidateMode = "NO_CANDIDATE"
+)
@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+type EventType string
+
+const (
+	EventPRCreated          EventType = "PR_CREATED"
+	EventPRMerged           EventType = "PR_MERGED"
+	EventReviewerAssigned   EventType = "REVIEWER_ASSIGNED"
+	EventReviewerUnassigned EventType = "REVIEWER_UNASSIGNED"
+)
+
+// Event — доменное событие по PR или назначению ревьювера.
+// ID — монотонно растущий номер из БД, используется как SSE id для Last-Event-ID.
+type Event struct {
+	ID         int64
+	Type       EventType
+	PRID       string
+	AuthorID   string
+	TeamName   string
+	ReviewerID string
+	TenantID   string
+	CreatedAt  time.Time
+}
+
+// EventFilter — фильтр подписки: пустые поля не ограничивают выборку.
+// TenantID нужен только подписке на шину: журнал в БД и так разделён по арендаторам.
+type EventFilter struct {
+	TeamName string
+	UserID   string
+	TenantID string
+}
+
+func (f EventFilter) Match(e Event) bool {
+	if f.TenantID != "" && f.TenantID != e.TenantID {
+		return false
+	}
+	if f.TeamName != "" && f.TeamName != e.TeamName {
+		return false
+	}
+	if f.UserID != "" && f.UserID != e.AuthorID && f.UserID != e.ReviewerID {
+		return false
+	}
+	return true
+}
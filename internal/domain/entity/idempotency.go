@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord — сохранённый ответ на запрос с Idempotency-Key.
+// StatusCode == 0 — запрос ещё выполняется.
+type IdempotencyRecord struct {
+	Scope       string
+	Key         string
+	RequestHash string
+	StatusCode  int
+	// Header — заголовки, выставленные хендлером (Content-Type, ETag и др.), отдаются при повторе
+	Header    map[string][]string
+	Body      []byte
+	ExpiresAt time.Time
+}
+
+func (r IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
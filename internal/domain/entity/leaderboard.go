@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+// LeaderboardSort — показатель, по которому ранжируются ревьюверы
+type LeaderboardSort string
+
+const (
+	// LeaderboardByCompleted — больше завершённых ревью выше
+	LeaderboardByCompleted LeaderboardSort = "completed"
+	// LeaderboardByOpen — больше открытых ревью выше
+	LeaderboardByOpen LeaderboardSort = "open"
+	// LeaderboardByTurnaround — быстрее выше, без завершённых ревью — в конце
+	LeaderboardByTurnaround LeaderboardSort = "turnaround"
+	// LeaderboardByReassignRate — реже снимаемые с ревью выше, без снятий и завершений — в конце
+	LeaderboardByReassignRate LeaderboardSort = "reassign_rate"
+)
+
+// LeaderboardFilter — участники команды TeamName (пусто — все пользователи) за полуинтервал [From, To)
+type LeaderboardFilter struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+	SortBy   LeaderboardSort
+}
+
+// LeaderboardEntry — нагрузка ревьювера за период. Ревью завершено, если PR смержен в периоде,
+// пока пользователь оставался его ревьювером; OpenReviews — текущие, без учёта периода.
+type LeaderboardEntry struct {
+	Rank     int
+	UserID   string
+	Username string
+	TeamName string
+	IsActive bool
+
+	ReviewsCompleted int
+	OpenReviews      int
+	// AvgTurnaroundHours — от назначения до merge по завершённым ревью; nil, если их нет.
+	// Для назначений без события в журнале — от создания PR.
+	AvgTurnaroundHours *float64
+	// AssignmentsReceived — назначения за период по журналу событий
+	AssignmentsReceived int
+	// ReassignedAway — сколько раз пользователя сняли с ревью за период
+	ReassignedAway int
+	// ReassignAwayRate — доля снятых среди закончившихся ревью: снятые / (снятые + завершённые);
+	// nil, если за период ни одно ревью не закончилось
+	ReassignAwayRate *float64
+}
@@ -0,0 +1,75 @@
+package entity
+
+import "time"
+
+type NotificationKind string
+
+const (
+	// NotificationStalePR — PR открыт дольше SLA команды
+	NotificationStalePR NotificationKind = "STALE_PR"
+	// NotificationReviewAssigned — пользователя назначили ревьювером
+	NotificationReviewAssigned NotificationKind = "REVIEW_ASSIGNED"
+)
+
+// Notification — сообщение пользователям о PR. Recipients — user_id адресатов; как их найти
+// (почта, чат, лог), решает канал.
+type Notification struct {
+	Kind       NotificationKind
+	PRID       string
+	PRName     string
+	AuthorID   string
+	TeamName   string
+	Recipients []string
+	// To — адреса для канала (почта); пусто — адреса из настроек канала
+	To []string
+	// Subject и Text — готовый текст для каналов, которым нужна строка
+	Subject   string
+	Text      string
+	CreatedAt time.Time
+}
+
+// NotificationChannel — канал, которым пользователь хочет получать уведомления о назначениях
+type NotificationChannel string
+
+const (
+	ChannelEmail NotificationChannel = "email"
+	ChannelChat  NotificationChannel = "chat"
+	ChannelFile  NotificationChannel = "file"
+)
+
+func (c NotificationChannel) Valid() bool {
+	switch c {
+	case ChannelEmail, ChannelChat, ChannelFile:
+		return true
+	}
+	return false
+}
+
+// NotificationPrefs — настройки уведомлений пользователя. Без сохранённых настроек Default=true,
+// и каналы берутся из конфигурации сервиса.
+type NotificationPrefs struct {
+	UserID   string
+	Channels []NotificationChannel
+	// Email — адрес для канала email
+	Email      string
+	QuietHours *QuietHours
+	Default    bool
+}
+
+// QuietHours — время суток, когда уведомления не отправляются. Start и End — смещение от полуночи
+// в часовом поясе Location; Start > End — интервал через полночь (22:00–08:00).
+type QuietHours struct {
+	Start    time.Duration
+	End      time.Duration
+	Location *time.Location
+}
+
+func (q QuietHours) Contains(t time.Time) bool {
+	// По часам, а не от полуночи: в дни перевода часов сутки не 24 часа
+	h, m, s := t.In(q.Location).Clock()
+	offset := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+	if q.Start <= q.End {
+		return offset >= q.Start && offset < q.End
+	}
+	return offset >= q.Start || offset < q.End
+}
@@ -10,6 +10,8 @@ type PullRequest struct {
 	CreatedAt *time.Time
 	MergedAt  *time.Time
 	Reviewers []string
+	// Version увеличивается при каждом изменении PR, включая состав ревьюверов
+	Version int64
 }
 
 type PRStatus string
@@ -19,10 +21,50 @@ const (
 	PRMerged PRStatus = "MERGED"
 )
 
+// ReviewerChange — замена ревьювера PR; пустой NewReviewerID — ревьювер снимается без замены
+type ReviewerChange struct {
+	PRID          string
+	OldReviewerID string
+	NewReviewerID string
+}
+
 type PRStats struct {
 	Total             int
 	Open              int
 	Merged            int
 	AvgReviewers      float64
 	AvgMergeTimeHours float64
+	// MergeTime — от создания до merge, по смерженным PR
+	MergeTime DurationStats
+	// FirstReviewTime — от создания до назначения первого ревьювера (по журналу событий)
+	FirstReviewTime DurationStats
+}
+
+// DurationStats — распределение длительностей в часах; при Count == 0 остальные поля нулевые
+type DurationStats struct {
+	Count       int
+	AvgHours    float64
+	MedianHours float64
+	P90Hours    float64
+	P99Hours    float64
+}
+
+// StatsFilter — выборка PR для статистики; пустые поля не ограничивают.
+// TeamName — команда автора, From/To — полуинтервал [From, To) по времени создания.
+type StatsFilter struct {
+	TeamName string
+	// Subteams — вместе с дочерними командами TeamName
+	Subteams bool
+	From     *time.Time
+	To       *time.Time
 }
+
+// ReviewerStrategy — как выбираются ревьюверы из активных участников команды
+type ReviewerStrategy string
+
+const (
+	// StrategyRandom — случайные кандидаты
+	StrategyRandom ReviewerStrategy = "random"
+	// StrategyLeastLoaded — кандидаты с наименьшим числом открытых ревью, при равенстве — случайно
+	StrategyLeastLoaded ReviewerStrategy = "least_loaded"
+)
@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// SLA — сроки для открытых PR авторов команды
+type SLA struct {
+	// RemindAfter — возраст открытого PR, после которого ревьюверам напоминают; повтор — с тем же интервалом
+	RemindAfter time.Duration
+	// ReassignAfter — сколько ревьювер может держать открытый PR, прежде чем его заменят; 0 — не заменять
+	ReassignAfter time.Duration
+}
+
+// TeamSLA — SLA команды; Default — своего нет, действуют значения из конфигурации
+type TeamSLA struct {
+	TeamName string
+	SLA
+	Default bool
+}
+
+// StalePR — открытый PR, о котором пора напомнить
+type StalePR struct {
+	PullRequest
+	TeamName string
+	SLA      SLA
+}
+
+// OverdueReview — назначение, которое ревьювер держит дольше SLA.ReassignAfter своей команды
+type OverdueReview struct {
+	PRID       string
+	ReviewerID string
+	AssignedAt time.Time
+}
+
+// StaleReport — итог одного прохода планировщика
+type StaleReport struct {
+	Reminded   int
+	Reassigned int
+	// Failed — напоминания и замены, которые не удались; они будут повторены на следующем проходе
+	Failed int
+}
@@ -3,6 +3,37 @@ package entity
 type Team struct {
 	Name    string
 	Members []User
+	// Version увеличивается при изменении состава или активности участников
+	Version int64
+	// ParentName — команда уровнем выше (отдел, организация); пусто — верхний уровень.
+	// Меняется только через SetTeamParent, AddOrUpdateTeam его не трогает
+	ParentName string
+}
+
+// TeamCounts — показатели команды: участники и PR её авторов, открытые ревью её участников
+type TeamCounts struct {
+	Members       int
+	ActiveMembers int
+	OpenPRs       int
+	MergedPRs     int
+	OpenReviews   int
+}
+
+func (c *TeamCounts) Add(o TeamCounts) {
+	c.Members += o.Members
+	c.ActiveMembers += o.ActiveMembers
+	c.OpenPRs += o.OpenPRs
+	c.MergedPRs += o.MergedPRs
+	c.OpenReviews += o.OpenReviews
+}
+
+// TeamNode — команда в иерархии: Own — только её участники, Total — вместе со всеми дочерними
+type TeamNode struct {
+	Name       string
+	ParentName string
+	Own        TeamCounts
+	Total      TeamCounts
+	Subteams   []TeamNode
 }
 
 type UserStats struct {
@@ -11,3 +42,28 @@ type UserStats struct {
 	ReviewedPRCount int
 	MergedPRCount   int
 }
+
+// TeamStats — статистика PR команды (по авторам-участникам) и распределение ревью между участниками.
+// С WithSubteams в расчёт входят и все дочерние команды
+type TeamStats struct {
+	TeamName     string
+	WithSubteams bool
+	PRs          PRStats
+	// Members — открытые ревью каждого участника, по убыванию нагрузки
+	Members []MemberLoad
+	// LoadGini — коэффициент Джини открытых ревью среди активных участников:
+	// 0 — поровну, ближе к 1 — всё на одном
+	LoadGini float64
+	// RequiredReviewers — сколько ревьюверов сервис назначает на PR
+	RequiredReviewers int
+	// Understaffed — открытые PR команды, где ревьюверов меньше RequiredReviewers
+	Understaffed []PullRequest
+}
+
+type MemberLoad struct {
+	UserID      string
+	TeamName    string
+	Username    string
+	IsActive    bool
+	OpenReviews int
+}
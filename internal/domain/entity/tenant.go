@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Tenant — организация со своими командами, пользователями и PR; идентификаторы
+// внутри арендатора независимы от других арендаторов
+type Tenant struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Granularity — размер интервала временного ряда
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "day"
+	GranularityWeek  Granularity = "week"
+	GranularityMonth Granularity = "month"
+)
+
+// TimeseriesFilter — ряд по команде (TeamName), по пользователю (UserID) или по всем командам.
+// From/To — полуинтервал дней [From, To); интервалы выравниваются по началу недели (понедельник)
+// или месяца.
+type TimeseriesFilter struct {
+	TeamName    string
+	UserID      string
+	From        time.Time
+	To          time.Time
+	Granularity Granularity
+}
+
+// TimeseriesPoint — агрегаты за интервал, начинающийся в Start; интервалы без активности — нули.
+// Для пользователя PR считаются по автору, назначения и снятия — по ревьюверу.
+type TimeseriesPoint struct {
+	Start           time.Time
+	PRsOpened       int
+	PRsMerged       int
+	ReviewsAssigned int
+	// Reassignments — ревьювер снят с PR: переназначение или деактивация
+	Reassignments int
+	// MedianMergeHours — по PR, смерженным в интервале; nil, если таких нет
+	MedianMergeHours *float64
+}
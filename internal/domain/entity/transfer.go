@@ -0,0 +1,39 @@
+package entity
+
+// TransferRecord — запись выгрузки: команда с участниками или PR с ревьюверами (заполнено одно поле).
+// Команды идут раньше PR, чтобы выгрузку можно было загрузить в том же порядке.
+// Line — строка файла, с которой начинается запись (0, если запись не из файла).
+type TransferRecord struct {
+	Team        *Team
+	PullRequest *PullRequest
+	Line        int
+}
+
+// ImportCounts — итог импорта по одному виду записей
+type ImportCounts struct {
+	Created   int
+	Updated   int
+	Unchanged int
+}
+
+const (
+	ConflictTeam        = "team"
+	ConflictUser        = "user"
+	ConflictPullRequest = "pull_request"
+)
+
+// ImportConflict — запись, которую нельзя применить; Line — как в TransferRecord
+type ImportConflict struct {
+	Line   int
+	Kind   string
+	ID     string
+	Reason string
+}
+
+type ImportResult struct {
+	DryRun       bool
+	Teams        ImportCounts
+	Users        ImportCounts
+	PullRequests ImportCounts
+	Conflicts    []ImportConflict
+}
@@ -5,4 +5,6 @@ type User struct {
 	Username string
 	TeamName string
 	IsActive bool
+	// Version увеличивается при каждом изменении пользователя (оптимистичная блокировка)
+	Version int64
 }
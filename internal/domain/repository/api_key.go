@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+type APIKeyRepository interface {
+	// Save создаёт ключ в арендаторе из ctx или обновляет роль/команду ключа с тем же хешем;
+	// ключ другого арендатора не перезаписывается — usecase.ErrAPIKeyTaken
+	Save(ctx context.Context, key entity.APIKey) (entity.APIKey, error)
+	// GetByHash возвращает действующий (не отозванный) ключ любого арендатора: по ключу арендатор
+	// и определяется
+	GetByHash(ctx context.Context, hash string) (entity.APIKey, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+type EventRepository interface {
+	// Append сохраняет события и возвращает их с присвоенными ID
+	Append(ctx context.Context, events []entity.Event) ([]entity.Event, error)
+	ListAfter(ctx context.Context, afterID int64, filter entity.EventFilter, limit int) ([]entity.Event, error)
+
+	// Cursor — сохранённая позиция читателя name в журнале арендатора. Читатель без позиции начинает
+	// с последнего события: историю, накопленную до его появления, он не разбирает.
+	Cursor(ctx context.Context, name string) (int64, error)
+	// SaveCursor сдвигает позицию вперёд; позиция назад не откатывается
+	SaveCursor(ctx context.Context, name string, lastID int64) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+type IdempotencyRepository interface {
+	// Claim резервирует ключ на ttl; пока ответа нет, резерв действует только lease. Истёкшая запись
+	// и незавершённая с истёкшим lease (процесс упал посреди запроса) перезаписываются.
+	// Если ключ уже занят, возвращает существующую запись и claimed=false.
+	Claim(ctx context.Context, rec entity.IdempotencyRecord, ttl, lease time.Duration) (existing entity.IdempotencyRecord, claimed bool, err error)
+	// Complete сохраняет ответ для повторов
+	Complete(ctx context.Context, rec entity.IdempotencyRecord) error
+	// Release снимает резерв, чтобы запрос можно было повторить (после ошибки сервера)
+	Release(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
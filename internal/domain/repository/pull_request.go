@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/mark47B/be-internship/internal/domain/entity"
 )
@@ -10,13 +11,29 @@ type PullRequestRepository interface {
 	Save(ctx context.Context, pr entity.PullRequest) error
 	Get(ctx context.Context, id string) (entity.PullRequest, error)
 	GetByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequest, error)
+	// Update сохраняет PR и увеличивает версию, если она всё ещё равна pr.Version;
+	// иначе — usecase.ErrVersionMismatch
 	Update(ctx context.Context, pr entity.PullRequest) error
 	GetReviewers(ctx context.Context, prID string) ([]string, error)
 	AssignReviewers(ctx context.Context, prID string, reviewerIDs []string) error
 	ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
 	RemoveReviewer(ctx context.Context, prID, reviewerID string) error
-	GetStats(ctx context.Context) (entity.PRStats, error)
+	// ApplyReviewerChanges применяет замены для любого числа PR за постоянное число запросов
+	ApplyReviewerChanges(ctx context.Context, changes []entity.ReviewerChange) error
+	// BumpVersions увеличивает версии PR одним запросом; если хотя бы у одного версия уже
+	// не равна pr.Version — usecase.ErrVersionMismatch
+	BumpVersions(ctx context.Context, prs []entity.PullRequest) error
+	GetStats(ctx context.Context, filter entity.StatsFilter) (entity.PRStats, error)
 	GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) ([]entity.PullRequest, error)
 	GetReviewersBatch(ctx context.Context, prIDs []string) (map[string][]string, error)
-	GetOpenPRsByTeam(ctx context.Context, teamName string) ([]entity.PullRequest, error)
+	// CountOpenReviews — число открытых PR, где пользователь ревьювер; есть ключ для каждого из userIDs
+	CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error)
+	// List — страница PR с id > afterID по возрастанию id, без ревьюверов
+	List(ctx context.Context, afterID string, limit int) ([]entity.PullRequest, error)
+	// ListStale — открытые PR старше RemindAfter команды автора, которым на момент now не напоминали
+	// дольше RemindAfter, с ревьюверами; def — SLA команд без собственного
+	ListStale(ctx context.Context, now time.Time, def entity.SLA) ([]entity.StalePR, error)
+	// ListOverdueReviews — назначения на открытые PR, которые на момент now старше ReassignAfter команды автора
+	ListOverdueReviews(ctx context.Context, now time.Time, def entity.SLA) ([]entity.OverdueReview, error)
+	MarkReminded(ctx context.Context, prIDs []string, at time.Time) error
 }
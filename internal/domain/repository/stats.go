@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+// StatsRepository — дневные агрегаты для временных рядов
+type StatsRepository interface {
+	// Rollup пересчитывает агрегаты за дни с from по to включительно; возвращает число строк
+	Rollup(ctx context.Context, from, to time.Time) (int, error)
+	// RollupStart — с какого дня пересчитывать: последний посчитанный день (он мог быть неполным),
+	// а если агрегатов ещё нет — день самой ранней активности. ok=false — данных нет вовсе.
+	RollupStart(ctx context.Context) (day time.Time, ok bool, err error)
+	Timeseries(ctx context.Context, filter entity.TimeseriesFilter) ([]entity.TimeseriesPoint, error)
+	// Leaderboard считает нагрузку всех пользователей из фильтра по user_id, без ранга и сортировки
+	Leaderboard(ctx context.Context, filter entity.LeaderboardFilter) ([]entity.LeaderboardEntry, error)
+}
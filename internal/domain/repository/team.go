@@ -9,4 +9,30 @@ import (
 type TeamRepository interface {
 	Save(ctx context.Context, team entity.Team) error
 	Get(ctx context.Context, name string) (entity.Team, error)
+	// Update увеличивает версию команды, если она всё ещё равна team.Version;
+	// иначе — usecase.ErrVersionMismatch
+	Update(ctx context.Context, team entity.Team) error
+	// List — все команды с участниками, по имени
+	List(ctx context.Context) ([]entity.Team, error)
+	// ReviewLoad — открытые ревью каждого участника команды (subteams — и дочерних), включая участников без ревью
+	ReviewLoad(ctx context.Context, name string, subteams bool) ([]entity.MemberLoad, error)
+	// Understaffed — открытые PR авторов из команды (subteams — и дочерних), где ревьюверов меньше required,
+	// с ревьюверами
+	Understaffed(ctx context.Context, name string, subteams bool, required int) ([]entity.PullRequest, error)
+	// SetParent переносит команду под parent (пусто — на верхний уровень);
+	// parent в поддереве команды — usecase.ErrTeamCycle
+	SetParent(ctx context.Context, name, parent string) error
+	// Ancestors — родитель команды, его родитель и так далее до верхнего уровня
+	Ancestors(ctx context.Context, name string) ([]string, error)
+	// Descendants — все дочерние команды на всех уровнях, без самой команды
+	Descendants(ctx context.Context, name string) ([]string, error)
+	// Hierarchy — команда и её поддерево (пусто — все команды) с собственными показателями (TeamNode.Own),
+	// плоским списком: родитель раньше дочерних
+	Hierarchy(ctx context.Context, name string) ([]entity.TeamNode, error)
+	// GetSLA — собственный SLA команды; ok=false — не задан
+	GetSLA(ctx context.Context, name string) (sla entity.SLA, ok bool, err error)
+	SaveSLA(ctx context.Context, name string, sla entity.SLA) error
+	// GetEscalation — правила эскалации команды; не заданы — пустые LeadUserID и EscalationTeam
+	GetEscalation(ctx context.Context, name string) (entity.EscalationPolicy, error)
+	SaveEscalation(ctx context.Context, policy entity.EscalationPolicy) error
 }
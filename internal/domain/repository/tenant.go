@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+// TenantRepository — справочник арендаторов; в отличие от остальных репозиториев не зависит
+// от арендатора в ctx
+type TenantRepository interface {
+	// Create — арендатор с таким id уже есть: usecase.ErrTenantExists
+	Create(ctx context.Context, t entity.Tenant) (entity.Tenant, error)
+	Get(ctx context.Context, id string) (entity.Tenant, error)
+	// List — все арендаторы, по id
+	List(ctx context.Context) ([]entity.Tenant, error)
+}
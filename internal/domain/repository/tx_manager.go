@@ -5,4 +5,6 @@ import "context"
 type TxManager interface {
 	Do(ctx context.Context, fn func(ctx context.Context) error) error
 	DoTx(ctx context.Context, fn func(ctx context.Context) (any, error)) (any, error)
+	// DoSnapshot — транзакция только на чтение: все запросы fn видят один снимок данных
+	DoSnapshot(ctx context.Context, fn func(ctx context.Context) error) error
 }
@@ -10,8 +10,16 @@ type UserRepository interface {
 	SaveUpdateMany(ctx context.Context, user []entity.User) error
 	Get(ctx context.Context, id string) (entity.User, error)
 	GetByTeam(ctx context.Context, teamName string) ([]entity.User, error)
+	// GetMany — пользователи с указанными id одним запросом; отсутствующие пропускаются
+	GetMany(ctx context.Context, ids []string) ([]entity.User, error)
 	GetActiveByTeam(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error)
 	UpdateMany(ctx context.Context, users []entity.User) error
+	// Update сохраняет активность пользователя, если его версия не изменилась (compare-and-swap):
+	// иначе usecase.ErrVersionMismatch
+	Update(ctx context.Context, user entity.User) error
 	GetUserStats(ctx context.Context, userID string) (entity.UserStats, error)
 	DeactivateMany(ctx context.Context, userIDs []string) error
+	// Сохранённые настройки уведомлений; у пользователей без настроек записи нет
+	GetNotificationPrefs(ctx context.Context, userIDs []string) ([]entity.NotificationPrefs, error)
+	SaveNotificationPrefs(ctx context.Context, prefs entity.NotificationPrefs) error
 }
@@ -0,0 +1,40 @@
+// Package tenant — арендатор (организация) текущего вызова. Данные разных арендаторов живут
+// в одних таблицах и разделяются по tenant_id; арендатор передаётся через контекст, как и транзакция,
+// поэтому методы репозиториев его не принимают — хранилище берёт его из ctx.
+package tenant
+
+import (
+	"context"
+	"regexp"
+)
+
+// Default — арендатор однотенантных инсталляций; в него миграция 011 перенесла все данные
+const Default = "default"
+
+// Тот же формат проверяет CHECK в таблице tenants
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Valid — id годится в идентификатор арендатора
+func Valid(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+type ctxKey struct{}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxKey{}).(string)
+	return id, ok && id != ""
+}
+
+// ID — арендатор из ctx. Вызовы без арендатора (админские команды, однотенантные инсталляции)
+// работают в Default; запросы к API получают арендатора при аутентификации всегда.
+func ID(ctx context.Context) string {
+	if id, ok := FromContext(ctx); ok {
+		return id
+	}
+	return Default
+}
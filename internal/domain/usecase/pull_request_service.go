@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mark47B/be-internship/internal/domain/entity"
 )
@@ -17,6 +18,22 @@ var (
 	ErrNotReviewer   = errors.New("user is not assigned reviewer")
 	ErrPRExists      = errors.New("PR already exists")
 	ErrUserNotInTeam = errors.New("user not belong the team")
+	// ErrConflict — конкурентное изменение тех же данных (уникальность, сериализация), запрос можно повторить
+	ErrConflict       = errors.New("concurrent modification conflict")
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrAPIKeyTaken — ключ с тем же хешем уже зарегистрирован у другого арендатора
+	ErrAPIKeyTaken = errors.New("api key is registered in another tenant")
+	// ErrVersionMismatch — версия ресурса не совпала с ожидаемой (If-Match или изменение между чтением и записью)
+	ErrVersionMismatch = errors.New("resource version mismatch")
+	// ErrImportConflict — файл импорта конфликтует с данными или сам с собой, ничего не записано
+	ErrImportConflict = errors.New("import has conflicts")
+	// ErrInvalidImport — файл импорта не разбирается (формат, размер), ничего не записано
+	ErrInvalidImport = errors.New("invalid import file")
+	// ErrTeamCycle — новый родитель команды лежит в её же поддереве (или это она сама)
+	ErrTeamCycle = errors.New("team hierarchy cycle")
+	// ErrTenantNotFound — арендатора из учётных данных или X-Tenant-ID нет
+	ErrTenantNotFound = errors.New("tenant not found")
+	ErrTenantExists   = errors.New("tenant already exists")
 )
 
 type TeamUseCase interface {
@@ -26,12 +43,32 @@ type TeamUseCase interface {
 	// Получить команду по имени
 	GetTeam(ctx context.Context, teamName string) (entity.Team, error)
 
-	// Массовая деактивация пользователей + безопасное переназначение PR
-	DeactivateUsersAndReassign(ctx context.Context, teamName string, userIDs []string) error
+	// Массовая деактивация пользователей + безопасное переназначение PR.
+	// ifVersion — ожидаемая версия команды (If-Match), 0 — без проверки
+	DeactivateUsersAndReassign(ctx context.Context, teamName string, userIDs []string, ifVersion int64) error
+	// Статистика PR команды и распределение открытых ревью между участниками;
+	// subteams — вместе со всеми дочерними командами
+	GetTeamStats(ctx context.Context, teamName string, subteams bool) (entity.TeamStats, error)
+
+	// Перенести команду под parent (пусто — на верхний уровень); цикл — ErrTeamCycle
+	SetTeamParent(ctx context.Context, teamName, parent string) (entity.Team, error)
+	// Дерево команд с показателями, свёрнутыми по поддеревьям: от teamName или от всех команд верхнего уровня
+	GetTeamTree(ctx context.Context, teamName string) ([]entity.TeamNode, error)
+
+	// SLA команды для открытых PR; без собственного — значения по умолчанию с Default=true
+	GetTeamSLA(ctx context.Context, teamName string) (entity.TeamSLA, error)
+	SetTeamSLA(ctx context.Context, teamName string, sla entity.SLA) (entity.TeamSLA, error)
+
+	// Правила эскалации, когда в команде нет кандидатов в ревьюверы; лид и команда эскалации должны существовать
+	GetTeamEscalation(ctx context.Context, teamName string) (entity.EscalationPolicy, error)
+	SetTeamEscalation(ctx context.Context, policy entity.EscalationPolicy) (entity.EscalationPolicy, error)
 }
 
 // Управление пользователями
 type UserUseCase interface {
+	// Получить пользователя
+	GetUser(ctx context.Context, userID string) (entity.User, error)
+
 	// Установить активность пользователя
 	SetUserActive(ctx context.Context, userID string, active bool) (entity.User, error)
 
@@ -40,21 +77,95 @@ type UserUseCase interface {
 
 	// Статистика по пользователю
 	GetUserStats(ctx context.Context, userID string) (entity.UserStats, error)
+
+	// Настройки уведомлений о назначениях; без сохранённых — каналы по умолчанию с Default=true
+	GetNotificationPrefs(ctx context.Context, userID string) (entity.NotificationPrefs, error)
+	SetNotificationPrefs(ctx context.Context, prefs entity.NotificationPrefs) (entity.NotificationPrefs, error)
 }
 
 // Управление PR
 type PullRequestUseCase interface {
-	// Создать PR + автоприсвоение ревьюверов
-	CreatePR(ctx context.Context, id, name, authorID string) (entity.PullRequest, error)
+	// Создать PR + автоприсвоение ревьюверов. Если в команде автора нет кандидатов — эскалация
+	// (см. entity.EscalationPolicy); не нашлось и там — PR без ревьюверов или ErrNoCandidates по mode
+	CreatePR(ctx context.Context, id, name, authorID string, mode entity.NoCandidateMode) (entity.PullRequest, error)
+
+	// Получить PR с ревьюверами
+	GetPR(ctx context.Context, id string) (entity.PullRequest, error)
+
+	// Идемпотентный merge; ifVersion — ожидаемая версия PR (If-Match), 0 — без проверки
+	MergePR(ctx context.Context, id string, ifVersion int64) (entity.PullRequest, error)
+
+	// Переназначить ревьювера; ifVersion — как в MergePR. Кандидаты и mode — как в CreatePR,
+	// только из команды старого ревьювера; без замены ревьювер снимается (replacedBy пустой)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID string, ifVersion int64, mode entity.NoCandidateMode) (updated entity.PullRequest, replacedBy string, err error)
+
+	// Получить aggregated stats; команда из фильтра должна существовать
+	GetPRStats(ctx context.Context, filter entity.StatsFilter) (entity.PRStats, error)
+}
+
+// Временные ряды по дневным агрегатам и рейтинг ревьюверов
+type StatsUseCase interface {
+	// RollupStats пересчитывает дневные агрегаты с дня from по день to включительно; нулевой from —
+	// с последнего посчитанного дня. Возвращает число записанных строк.
+	RollupStats(ctx context.Context, from, to time.Time) (int, error)
+
+	// Ряд за период; команда или пользователь из фильтра должны существовать
+	GetTimeseries(ctx context.Context, filter entity.TimeseriesFilter) ([]entity.TimeseriesPoint, error)
 
-	// Идемпотентный merge
-	MergePR(ctx context.Context, id string) (entity.PullRequest, error)
+	// Рейтинг ревьюверов команды или всех пользователей за период; команда должна существовать
+	GetLeaderboard(ctx context.Context, filter entity.LeaderboardFilter) ([]entity.LeaderboardEntry, error)
+}
+
+// Поток событий по PR и назначениям ревьюверов
+type EventUseCase interface {
+	// События с ID > afterID в порядке возрастания (для Last-Event-ID)
+	ListEvents(ctx context.Context, afterID int64, filter entity.EventFilter, limit int) ([]entity.Event, error)
+
+	// Подписка на новые события; возвращённая функция отменяет подписку
+	SubscribeEvents(filter entity.EventFilter) (<-chan entity.Event, func())
+}
+
+// Перенос данных между инсталляциями: резервная копия и массовая загрузка
+type TransferUseCase interface {
+	// Export передаёт в emit все команды (с участниками), затем все PR (с ревьюверами) —
+	// из одного снимка БД, так что выгрузка согласована сама с собой
+	Export(ctx context.Context, emit func(entity.TransferRecord) error) error
+
+	// Import читает записи из src пачками и применяет их в одной транзакции: команды и участники — upsert,
+	// новые PR создаются с указанными ревьюверами, у существующих обновляются имя и статус.
+	// При конфликтах транзакция откатывается: ничего не пишется, результат содержит их список
+	// и возвращается ErrImportConflict. Ошибка чтения src — ErrInvalidImport.
+	// dryRun — только проверка и подсчёт, без записи.
+	Import(ctx context.Context, src RecordReader, dryRun bool) (entity.ImportResult, error)
+}
+
+// RecordReader отдаёт записи импорта по одной; конец файла — io.EOF
+type RecordReader interface {
+	Read() (entity.TransferRecord, error)
+}
 
-	// Переназначить ревьювера
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (updated entity.PullRequest, replacedBy string, err error)
+// Шина событий: сервис публикует в неё события после коммита транзакции.
+// Канал подписчика закрывается при остановке шины или если подписчик не успевает читать.
+type EventBus interface {
+	Publish(events ...entity.Event)
+	Subscribe(filter entity.EventFilter) (<-chan entity.Event, func())
+}
+
+// Канал доставки уведомлений пользователям. Ошибка — уведомление не доставлено,
+// отправитель может повторить его позже.
+type Notifier interface {
+	Notify(ctx context.Context, n entity.Notification) error
+}
 
-	// Получить aggregated stats
-	GetPRStats(ctx context.Context) (entity.PRStats, error)
+// Доменные метрики; сервис вызывает их после успешного коммита.
+// operation — "create", "reassign" или "deactivation", n — число назначений.
+type Metrics interface {
+	PRCreated()
+	PRMerged()
+	ReviewerReassigned(operation string, n int)
+	NoCandidates(operation string, n int)
+	// Escalated — назначения вне команды; target — "lead" или "team"
+	Escalated(operation, target string, n int)
 }
 
 // Фасад для агрегации интерфейсов сервиса
@@ -62,4 +173,7 @@ type Service interface {
 	TeamUseCase
 	UserUseCase
 	PullRequestUseCase
+	EventUseCase
+	TransferUseCase
+	StatsUseCase
 }
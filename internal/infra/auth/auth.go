@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("access denied")
+)
+
+type Options struct {
+	// JWKSFile — путь к JWKS с публичными ключами; пусто — JWT не принимаются
+	JWKSFile string
+	// Issuer и Audience — ожидаемые iss/aud токена; пусто — не проверяются
+	Issuer   string
+	Audience string
+}
+
+// Authenticator проверяет API-ключи (по хешу в Postgres) и JWT (по ключам из JWKS-файла)
+// и определяет арендатора вызова
+type Authenticator struct {
+	keys     repository.APIKeyRepository
+	teams    repository.TeamRepository
+	tenants  repository.TenantRepository
+	jwt      *jwtVerifier
+	disabled bool
+}
+
+// NewAuthenticator — teams нужен, чтобы team_lead управлял и дочерними командами своей команды,
+// tenants — чтобы проверить арендатора из JWT
+func NewAuthenticator(
+	keys repository.APIKeyRepository,
+	teams repository.TeamRepository,
+	tenants repository.TenantRepository,
+	opts Options,
+) (*Authenticator, error) {
+	a := &Authenticator{keys: keys, teams: teams, tenants: tenants}
+	if opts.JWKSFile != "" {
+		v, err := newJWTVerifier(opts)
+		if err != nil {
+			return nil, err
+		}
+		a.jwt = v
+	}
+	return a, nil
+}
+
+// Disabled — аутентификация выключена, любой вызов выполняется как admin арендатора из X-Tenant-ID
+func Disabled(tenants repository.TenantRepository) *Authenticator {
+	return &Authenticator{tenants: tenants, disabled: true}
+}
+
+var anonymous = entity.Principal{Subject: "anonymous", Role: entity.RoleAdmin}
+
+// Authenticate проверяет API-ключ или bearer-токен (что передано) и определяет арендатора, см. withTenant.
+// Любая проблема с учётными данными — ErrUnauthenticated, причина только в обёрнутой ошибке.
+func (a *Authenticator) Authenticate(ctx context.Context, apiKey, bearer, tenantID string) (entity.Principal, error) {
+	var p entity.Principal
+	var err error
+	switch {
+	case a.disabled:
+		p = anonymous
+	case apiKey != "":
+		p, err = a.authenticateAPIKey(ctx, apiKey)
+	case bearer != "" && a.jwt != nil:
+		p, err = a.jwt.verify(bearer)
+		if err != nil {
+			err = fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+		}
+	default:
+		err = ErrUnauthenticated
+	}
+	if err != nil {
+		return entity.Principal{}, err
+	}
+	// Арендатор ключа существует по внешнему ключу в БД, остальных проверяем
+	if p, err = a.withTenant(ctx, p, tenantID, a.disabled || apiKey == ""); err != nil {
+		return entity.Principal{}, err
+	}
+	return a.withSubteams(tenant.NewContext(ctx, p.TenantID), p)
+}
+
+// withTenant: арендатор API-ключа — тот, в котором ключ создан, JWT — из claim tenant (без него — default).
+// X-Tenant-ID выбирает арендатора, только когда аутентификация выключена; иначе он может лишь совпасть
+// с арендатором учётных данных.
+func (a *Authenticator) withTenant(ctx context.Context, p entity.Principal, requested string, check bool) (entity.Principal, error) {
+	switch {
+	case a.disabled && requested != "":
+		p.TenantID = requested
+	case p.TenantID == "":
+		p.TenantID = tenant.Default
+	}
+	if requested != "" && requested != p.TenantID {
+		return entity.Principal{}, fmt.Errorf("%w: credentials belong to tenant %q", ErrForbidden, p.TenantID)
+	}
+
+	if !check || a.tenants == nil {
+		return p, nil
+	}
+	if !tenant.Valid(p.TenantID) {
+		return entity.Principal{}, usecase.ErrTenantNotFound
+	}
+	if _, err := a.tenants.Get(ctx, p.TenantID); err != nil {
+		return entity.Principal{}, err
+	}
+	return p, nil
+}
+
+// withSubteams дописывает team_lead'у дочерние команды его команды: иерархия меняется на лету,
+// поэтому читается на каждый запрос, а не хранится в ключе или токене
+func (a *Authenticator) withSubteams(ctx context.Context, p entity.Principal) (entity.Principal, error) {
+	if p.Role != entity.RoleTeamLead || p.TeamName == "" || a.teams == nil {
+		return p, nil
+	}
+	subteams, err := a.teams.Descendants(ctx, p.TeamName)
+	if err != nil {
+		return entity.Principal{}, fmt.Errorf("load subteams: %w", err)
+	}
+	p.Subteams = subteams
+	return p, nil
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, apiKey string) (entity.Principal, error) {
+	key, err := a.keys.GetByHash(ctx, HashAPIKey(apiKey))
+	if err != nil {
+		if errors.Is(err, usecase.ErrAPIKeyNotFound) {
+			return entity.Principal{}, fmt.Errorf("%w: unknown api key", ErrUnauthenticated)
+		}
+		return entity.Principal{}, err
+	}
+
+	return entity.Principal{
+		Subject:  "apikey:" + key.Name,
+		Role:     key.Role,
+		TeamName: key.TeamName,
+		TenantID: key.TenantID,
+	}, nil
+}
+
+// SaveAPIKey регистрирует ключ с ролью в арендаторе из ctx; в БД попадает только хеш, сам ключ хранит владелец
+func SaveAPIKey(ctx context.Context, keys repository.APIKeyRepository, name, apiKey string, role entity.Role, team string) (entity.APIKey, error) {
+	if apiKey == "" {
+		return entity.APIKey{}, errors.New("api key is empty")
+	}
+	if !role.Valid() {
+		return entity.APIKey{}, fmt.Errorf("unknown role %q", role)
+	}
+	if role == entity.RoleTeamLead && team == "" {
+		return entity.APIKey{}, errors.New("team_lead key requires team")
+	}
+
+	return keys.Save(ctx, entity.APIKey{
+		Name:     name,
+		Hash:     HashAPIKey(apiKey),
+		Role:     role,
+		TeamName: team,
+	})
+}
+
+// HashAPIKey — ключи длинные и случайные, поэтому достаточно sha256 без соли
+func HashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// Allow проверяет, что роль вызывающего входит в разрешённые для операции
+func Allow(p entity.Principal, roles []entity.Role) error {
+	if slices.Contains(roles, p.Role) {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// CheckTeam ограничивает team_lead его командой и её дочерними; admin может всё.
+// Остальные роли сюда доходить не должны, но на всякий случай получают отказ.
+func CheckTeam(ctx context.Context, teamName string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	switch p.Role {
+	case entity.RoleAdmin:
+		return nil
+	case entity.RoleTeamLead:
+		if p.TeamName == teamName || slices.Contains(p.Subteams, teamName) {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+type ctxKey struct{}
+
+func NewContext(ctx context.Context, p entity.Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+func FromContext(ctx context.Context) (entity.Principal, bool) {
+	p, ok := ctx.Value(ctxKey{}).(entity.Principal)
+	return p, ok
+}
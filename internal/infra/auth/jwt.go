@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+// Допуск на рассинхронизацию часов с выпускающим токены сервисом
+const clockSkew = 30 * time.Second
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// roleClaims — наши claims поверх стандартных: роль, команда (для team_lead) и арендатор
+type roleClaims struct {
+	Role   entity.Role `json:"role"`
+	Team   string      `json:"team"`
+	Tenant string      `json:"tenant"`
+}
+
+type jwtVerifier struct {
+	keys     jose.JSONWebKeySet
+	issuer   string
+	audience string
+}
+
+func newJWTVerifier(opts Options) (*jwtVerifier, error) {
+	data, err := os.ReadFile(opts.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks file: %w", err)
+	}
+
+	v := &jwtVerifier{issuer: opts.Issuer, audience: opts.Audience}
+	if err := json.Unmarshal(data, &v.keys); err != nil {
+		return nil, fmt.Errorf("parse jwks file: %w", err)
+	}
+	if len(v.keys.Keys) == 0 {
+		return nil, errors.New("jwks file contains no keys")
+	}
+	for _, k := range v.keys.Keys {
+		if !k.IsPublic() {
+			return nil, fmt.Errorf("jwks key %q is not a public key", k.KeyID)
+		}
+	}
+
+	return v, nil
+}
+
+func (v *jwtVerifier) verify(raw string) (entity.Principal, error) {
+	tok, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		return entity.Principal{}, fmt.Errorf("parse token: %w", err)
+	}
+
+	key, err := v.key(tok)
+	if err != nil {
+		return entity.Principal{}, err
+	}
+
+	var (
+		std    jwt.Claims
+		custom roleClaims
+	)
+	if err := tok.Claims(key, &std, &custom); err != nil {
+		return entity.Principal{}, fmt.Errorf("verify token: %w", err)
+	}
+
+	if std.Expiry == nil {
+		return entity.Principal{}, errors.New("token has no exp")
+	}
+	expected := jwt.Expected{Issuer: v.issuer, Time: time.Now()}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+	if err := std.ValidateWithLeeway(expected, clockSkew); err != nil {
+		return entity.Principal{}, fmt.Errorf("validate token: %w", err)
+	}
+
+	if !custom.Role.Valid() {
+		return entity.Principal{}, fmt.Errorf("unknown role %q", custom.Role)
+	}
+	if custom.Role == entity.RoleTeamLead && custom.Team == "" {
+		return entity.Principal{}, errors.New("team_lead token without team")
+	}
+
+	return entity.Principal{
+		Subject:  "jwt:" + std.Subject,
+		Role:     custom.Role,
+		TeamName: custom.Team,
+		TenantID: custom.Tenant,
+	}, nil
+}
+
+// key выбирает ключ по kid; без kid допустим только JWKS из одного ключа
+func (v *jwtVerifier) key(tok *jwt.JSONWebToken) (jose.JSONWebKey, error) {
+	if len(tok.Headers) == 0 {
+		return jose.JSONWebKey{}, errors.New("token has no header")
+	}
+
+	kid := tok.Headers[0].KeyID
+	if kid == "" {
+		if len(v.keys.Keys) == 1 {
+			return v.keys.Keys[0], nil
+		}
+		return jose.JSONWebKey{}, errors.New("token has no kid")
+	}
+
+	keys := v.keys.Key(kid)
+	if len(keys) == 0 {
+		return jose.JSONWebKey{}, fmt.Errorf("unknown kid %q", kid)
+	}
+	return keys[0], nil
+}
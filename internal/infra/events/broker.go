@@ -0,0 +1,100 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+// subscriberBuffer — сколько событий может накопиться у медленного подписчика,
+// прежде чем его отключат (клиент переподключится с Last-Event-ID и дочитает из БД)
+const subscriberBuffer = 256
+
+var _ usecase.EventBus = (*Broker)(nil)
+
+type subscriber struct {
+	ch     chan entity.Event
+	filter entity.EventFilter
+}
+
+// Broker — in-memory fan-out событий подписчикам текущего процесса
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[*subscriber]struct{}
+	closed bool
+}
+
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[*subscriber]struct{})}
+}
+
+func (b *Broker) Publish(events ...entity.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		b.deliver(sub, events)
+	}
+}
+
+func (b *Broker) Subscribe(filter entity.EventFilter) (<-chan entity.Event, func()) {
+	sub := &subscriber{
+		ch:     make(chan entity.Event, subscriberBuffer),
+		filter: filter,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+	b.subs[sub] = struct{}{}
+
+	return sub.ch, func() { b.unsubscribe(sub) }
+}
+
+// Close закрывает каналы всех подписчиков; используется при graceful shutdown,
+// чтобы долгоживущие SSE-соединения завершились до srv.Shutdown
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// deliver вызывается под b.mu
+func (b *Broker) deliver(sub *subscriber, events []entity.Event) {
+	for _, e := range events {
+		if !sub.filter.Match(e) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			// Подписчик не успевает — отключаем, чтобы не блокировать остальных
+			delete(b.subs, sub)
+			close(sub.ch)
+			return
+		}
+	}
+}
+
+func (b *Broker) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
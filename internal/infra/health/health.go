@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+type Component struct {
+	Status  string
+	Message string
+}
+
+type Report struct {
+	Status     string
+	Components map[string]Component
+}
+
+// Checker отвечает на readiness: сервис готов принимать трафик, если доступна БД,
+// схема в ней той версии, которую ждёт бинарник, и сервер не останавливается
+type Checker struct {
+	db            *sql.DB
+	schemaVersion uint
+	timeout       time.Duration
+	shuttingDown  atomic.Bool
+}
+
+func NewChecker(db *sql.DB, schemaVersion uint, timeout time.Duration) *Checker {
+	return &Checker{db: db, schemaVersion: schemaVersion, timeout: timeout}
+}
+
+// Shutdown переводит readiness в fail: балансировщик перестаёт слать новые запросы,
+// пока сервер дорабатывает текущие
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+func (c *Checker) Ready(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	components := map[string]Component{
+		"database": c.checkDatabase(ctx),
+	}
+	if components["database"].Status == StatusOK {
+		components["migrations"] = c.checkMigrations(ctx)
+	} else {
+		components["migrations"] = Component{Status: StatusFail, Message: "database is unavailable"}
+	}
+	if c.shuttingDown.Load() {
+		components["server"] = Component{Status: StatusFail, Message: "shutting down"}
+	} else {
+		components["server"] = Component{Status: StatusOK}
+	}
+
+	report := Report{Status: StatusOK, Components: components}
+	for _, comp := range components {
+		if comp.Status != StatusOK {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+func (c *Checker) checkDatabase(ctx context.Context) Component {
+	// Текст ошибки только в лог: эндпоинт публичный
+	if err := c.db.PingContext(ctx); err != nil {
+		slog.WarnContext(ctx, "readiness: database ping failed", "error", err)
+		return Component{Status: StatusFail, Message: "ping failed"}
+	}
+	return Component{Status: StatusOK}
+}
+
+func (c *Checker) checkMigrations(ctx context.Context) Component {
+	version, dirty, err := pg.SchemaVersion(ctx, c.db)
+	if err != nil {
+		slog.WarnContext(ctx, "readiness: schema version check failed", "error", err)
+		return Component{Status: StatusFail, Message: "cannot read schema version"}
+	}
+	msg := fmt.Sprintf("applied %d, expected %d", version, c.schemaVersion)
+	switch {
+	case dirty:
+		return Component{Status: StatusFail, Message: msg + ", dirty"}
+	case version != c.schemaVersion:
+		return Component{Status: StatusFail, Message: msg}
+	}
+	return Component{Status: StatusOK, Message: msg}
+}
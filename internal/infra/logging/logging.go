@@ -0,0 +1,98 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ParseLevel разбирает debug | info | warn | error
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(s))); err != nil {
+		return slog.LevelInfo, fmt.Errorf("invalid log level %q: %w", s, err)
+	}
+	return level, nil
+}
+
+// Setup делает JSON-логгер логгером по умолчанию (в том числе для пакета log).
+// Сервис и хранилище пишут через slog.*Context(ctx, ...): обработчик сам добавляет атрибуты
+// запроса из контекста (request_id, user) и trace_id/span_id текущего спана.
+func Setup(w io.Writer, level slog.Level) *slog.Logger {
+	logger := slog.New(&contextHandler{Handler: slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+	slog.SetDefault(logger)
+	return logger
+}
+
+// attrSet — атрибуты запроса; общий указатель позволяет внутренним middleware
+// дописать атрибут (например, пользователя после аутентификации) так, чтобы его видели и внешние
+type attrSet struct {
+	mu    sync.Mutex
+	attrs []slog.Attr
+}
+
+type ctxKey struct{}
+
+// WithAttrs открывает набор атрибутов запроса, унаследовав уже добавленные выше по контексту
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	set := &attrSet{}
+	if parent := fromContext(ctx); parent != nil {
+		set.attrs = parent.list()
+	}
+	set.attrs = append(set.attrs, attrs...)
+	return context.WithValue(ctx, ctxKey{}, set)
+}
+
+// AddAttrs дописывает атрибуты в набор, открытый WithAttrs; без набора ничего не делает
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	set := fromContext(ctx)
+	if set == nil {
+		return
+	}
+	set.mu.Lock()
+	set.attrs = append(set.attrs, attrs...)
+	set.mu.Unlock()
+}
+
+func fromContext(ctx context.Context) *attrSet {
+	set, _ := ctx.Value(ctxKey{}).(*attrSet)
+	return set
+}
+
+func (s *attrSet) list() []slog.Attr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]slog.Attr(nil), s.attrs...)
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if set := fromContext(ctx); set != nil {
+			r.AddAttrs(set.list()...)
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			r.AddAttrs(
+				slog.String("trace_id", sc.TraceID().String()),
+				slog.String("span_id", sc.SpanID().String()),
+			)
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+const namespace = "pr_reviewer"
+
+// compile-time proof
+var _ usecase.Metrics = (*Metrics)(nil)
+
+// Metrics — метрики сервиса в собственном реестре (без глобального prometheus.DefaultRegisterer,
+// чтобы в тестах можно было создавать несколько экземпляров)
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpDuration *prometheus.HistogramVec
+	transactions *prometheus.CounterVec
+
+	prsCreated    prometheus.Counter
+	prsMerged     prometheus.Counter
+	reassignments *prometheus.CounterVec
+	noCandidates  *prometheus.CounterVec
+	escalations   *prometheus.CounterVec
+}
+
+// New регистрирует метрики; db — пул, статистика которого отдаётся как go_sql_*
+// (nil — без статистики пула)
+func New(db *sql.DB) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request duration by chi route pattern and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		transactions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "db_transactions_total",
+			Help:      "TxManager transactions by result (commit or rollback).",
+		}, []string{"result"}),
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "prs_created_total",
+			Help:      "Pull requests created.",
+		}),
+		prsMerged: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "prs_merged_total",
+			Help:      "Pull requests merged (repeated merges are not counted).",
+		}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Reviewer replacements by operation (reassign or deactivation).",
+		}, []string{"operation"}),
+		noCandidates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_no_candidates_total",
+			Help:      "Reviewer assignments that found no active candidate in the team.",
+		}, []string{"operation"}),
+		escalations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_escalations_total",
+			Help:      "Reviewers assigned by team escalation rules (team lead, escalation team or parent teams).",
+		}, []string{"operation", "target"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration,
+		m.transactions,
+		m.prsCreated,
+		m.prsMerged,
+		m.reassignments,
+		m.noCandidates,
+		m.escalations,
+	)
+	if db != nil {
+		m.registry.MustRegister(collectors.NewDBStatsCollector(db, "postgres"))
+	}
+
+	return m
+}
+
+// Handler — эндпоинт /metrics в текстовом формате Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTP учитывает завершённый HTTP-запрос
+func (m *Metrics) ObserveHTTP(method, route string, status int, seconds float64) {
+	m.httpDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(seconds)
+}
+
+func (m *Metrics) PRCreated() {
+	m.prsCreated.Inc()
+}
+
+func (m *Metrics) PRMerged() {
+	m.prsMerged.Inc()
+}
+
+func (m *Metrics) ReviewerReassigned(operation string, n int) {
+	m.reassignments.WithLabelValues(operation).Add(float64(n))
+}
+
+func (m *Metrics) NoCandidates(operation string, n int) {
+	m.noCandidates.WithLabelValues(operation).Add(float64(n))
+}
+
+func (m *Metrics) Escalated(operation, target string, n int) {
+	m.escalations.WithLabelValues(operation, target).Add(float64(n))
+}
+
+// InstrumentTxManager считает коммиты и откаты транзакций.
+// Ошибка fn или коммита — rollback: изменения в БД не попали.
+func (m *Metrics) InstrumentTxManager(tx repository.TxManager) repository.TxManager {
+	return &txManager{next: tx, counter: m.transactions}
+}
+
+type txManager struct {
+	next    repository.TxManager
+	counter *prometheus.CounterVec
+}
+
+func (t *txManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := t.next.Do(ctx, fn)
+	t.observe(err)
+	return err
+}
+
+func (t *txManager) DoTx(ctx context.Context, fn func(ctx context.Context) (any, error)) (any, error) {
+	res, err := t.next.DoTx(ctx, fn)
+	t.observe(err)
+	return res, err
+}
+
+func (t *txManager) DoSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := t.next.DoSnapshot(ctx, fn)
+	t.observe(err)
+	return err
+}
+
+func (t *txManager) observe(err error) {
+	if err != nil {
+		t.counter.WithLabelValues("rollback").Inc()
+		return
+	}
+	t.counter.WithLabelValues("commit").Inc()
+}
@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+type chatNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewChat отправляет уведомления во входящий webhook чата. Тело — {"text": "..."}: этот формат
+// понимают Slack, Mattermost и совместимые с ними чаты; адресаты перечисляются в тексте.
+func NewChat(url string) usecase.Notifier {
+	return &chatNotifier{url: url, client: &http.Client{Timeout: webhookTimeout}}
+}
+
+// ChatMessage — тело запроса в чат
+type ChatMessage struct {
+	Text string `json:"text"`
+}
+
+func (c *chatNotifier) Notify(ctx context.Context, n entity.Notification) error {
+	var b strings.Builder
+	b.WriteString("*" + n.Subject + "*\n")
+	b.WriteString(n.Text)
+	if len(n.Recipients) > 0 {
+		b.WriteString("\nFor: " + strings.Join(n.Recipients, ", "))
+	}
+
+	body, err := json.Marshal(ChatMessage{Text: b.String()})
+	if err != nil {
+		return fmt.Errorf("chat: encode: %w", err)
+	}
+	if err := postJSON(ctx, c.client, c.url, body); err != nil {
+		return fmt.Errorf("chat: %w", err)
+	}
+	return nil
+}
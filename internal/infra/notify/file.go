@@ -0,0 +1,38 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+type fileNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFile пишет уведомления в w по одному JSON на строку (поля как у WebhookPayload) —
+// для stdout или файла, который читает внешний агент
+func NewFile(w io.Writer) usecase.Notifier {
+	return &fileNotifier{w: w}
+}
+
+func (f *fileNotifier) Notify(_ context.Context, n entity.Notification) error {
+	line, err := json.Marshal(payloadOf(n))
+	if err != nil {
+		return fmt.Errorf("file: encode: %w", err)
+	}
+	line = append(line, '\n')
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.w.Write(line); err != nil {
+		return fmt.Errorf("file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+type logNotifier struct{}
+
+// NewLog пишет уведомления в лог сервиса — канал по умолчанию, когда доставка настроена не была
+func NewLog() usecase.Notifier {
+	return logNotifier{}
+}
+
+func (logNotifier) Notify(ctx context.Context, n entity.Notification) error {
+	slog.InfoContext(ctx, "notification",
+		"kind", n.Kind,
+		"pr_id", n.PRID,
+		"team_name", n.TeamName,
+		"recipients", n.Recipients,
+		"text", n.Text,
+	)
+	return nil
+}
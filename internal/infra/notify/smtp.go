@@ -0,0 +1,60 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+type smtpNotifier struct {
+	addr string
+	from string
+	to   []string
+}
+
+// NewSMTP отправляет уведомления письмом через SMTP-сервер addr (host:port) без аутентификации —
+// рассчитано на локальный релей или тестовый сервер вроде Mailpit. Письмо уходит на адреса из
+// уведомления (Notification.To — почта из настроек пользователя), а если их нет — на адреса to;
+// адресаты уведомления перечисляются в тексте.
+func NewSMTP(addr, from string, to []string) usecase.Notifier {
+	return &smtpNotifier{addr: addr, from: from, to: to}
+}
+
+func (s *smtpNotifier) Notify(ctx context.Context, n entity.Notification) error {
+	// net/smtp не принимает контекст; отменённый проход хотя бы не начинает новых писем
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	to := n.To
+	if len(to) == 0 {
+		to = s.to
+	}
+	if len(to) == 0 {
+		return fmt.Errorf("smtp: no recipient addresses")
+	}
+	if err := smtp.SendMail(s.addr, nil, s.from, to, s.message(n, to)); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return nil
+}
+
+func (s *smtpNotifier) message(n entity.Notification, to []string) []byte {
+	var b strings.Builder
+	header := func(k, v string) { b.WriteString(k + ": " + v + "\r\n") }
+	header("From", s.from)
+	header("To", strings.Join(to, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", n.Subject))
+	header("Date", n.CreatedAt.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	b.WriteString("\r\n")
+	b.WriteString(n.Text + "\r\n\r\n")
+	b.WriteString("Recipients: " + strings.Join(n.Recipients, ", ") + "\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,89 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+// webhookTimeout — сколько ждём ответа получателя; зависший приёмник не должен задерживать планировщик
+const webhookTimeout = 10 * time.Second
+
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook отправляет каждое уведомление POST-запросом с JSON (см. WebhookPayload);
+// любой ответ кроме 2xx — ошибка доставки
+func NewWebhook(url string) usecase.Notifier {
+	return &webhookNotifier{url: url, client: &http.Client{Timeout: webhookTimeout}}
+}
+
+// WebhookPayload — тело запроса webhook
+type WebhookPayload struct {
+	Kind       entity.NotificationKind `json:"kind"`
+	PRID       string                  `json:"pull_request_id"`
+	PRName     string                  `json:"pull_request_name"`
+	AuthorID   string                  `json:"author_id"`
+	TeamName   string                  `json:"team_name"`
+	Recipients []string                `json:"recipients"`
+	Subject    string                  `json:"subject"`
+	Text       string                  `json:"text"`
+	CreatedAt  time.Time               `json:"created_at"`
+}
+
+func payloadOf(n entity.Notification) WebhookPayload {
+	return WebhookPayload{
+		Kind:       n.Kind,
+		PRID:       n.PRID,
+		PRName:     n.PRName,
+		AuthorID:   n.AuthorID,
+		TeamName:   n.TeamName,
+		Recipients: n.Recipients,
+		Subject:    n.Subject,
+		Text:       n.Text,
+		CreatedAt:  n.CreatedAt,
+	}
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n entity.Notification) error {
+	body, err := json.Marshal(payloadOf(n))
+	if err != nil {
+		return fmt.Errorf("webhook: encode: %w", err)
+	}
+
+	if err := postJSON(ctx, w.client, w.url, body); err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	return nil
+}
+
+// postJSON — POST с JSON-телом; любой ответ кроме 2xx — ошибка
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Дочитываем тело, чтобы соединение вернулось в пул
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
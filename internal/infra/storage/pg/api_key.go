@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+type APIKeyStorage struct {
+	db *sql.DB
+}
+
+func NewAPIKeyStorage(db *sql.DB) repository.APIKeyRepository {
+	return &APIKeyStorage{db: db}
+}
+
+func (s *APIKeyStorage) getQuerier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok && tx != nil {
+		return tx
+	}
+	return s.db
+}
+
+func (s *APIKeyStorage) Save(ctx context.Context, key entity.APIKey) (entity.APIKey, error) {
+	q := s.getQuerier(ctx)
+
+	// Хеш уникален на всю инсталляцию, а строку другого арендатора политика не даст обновить:
+	// ON CONFLICT упал бы отказом RLS. Проверяем заранее, чтобы вернуть понятную ошибку
+	var owner string
+	err := q.QueryRowContext(ctx, `
+		SELECT k.tenant_id FROM api_key_by_hash($1) k WHERE k.tenant_id IS DISTINCT FROM current_tenant()
+	`, key.Hash).Scan(&owner)
+	switch {
+	case err == nil:
+		return entity.APIKey{}, usecase.ErrAPIKeyTaken
+	case !errors.Is(err, sql.ErrNoRows):
+		return entity.APIKey{}, fmt.Errorf("save api key: check owner: %w", err)
+	}
+
+	err = q.QueryRowContext(ctx, `
+		INSERT INTO api_keys (name, key_hash, role, team_name)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key_hash) DO UPDATE SET
+			name = EXCLUDED.name,
+			role = EXCLUDED.role,
+			team_name = EXCLUDED.team_name,
+			revoked_at = NULL
+		RETURNING id, tenant_id, created_at
+	`, key.Name, key.Hash, string(key.Role), key.TeamName).Scan(&key.ID, &key.TenantID, &key.CreatedAt)
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("save api key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (s *APIKeyStorage) GetByHash(ctx context.Context, hash string) (entity.APIKey, error) {
+	q := s.getQuerier(ctx)
+
+	var (
+		key  entity.APIKey
+		role string
+	)
+	// Арендатор ещё не известен: ищем мимо политики, функцией из миграции 011
+	err := q.QueryRowContext(ctx, `
+		SELECT id, name, key_hash, role, team_name, tenant_id, created_at
+		FROM api_key_by_hash($1)
+		WHERE revoked_at IS NULL
+	`, hash).Scan(&key.ID, &key.Name, &key.Hash, &role, &key.TeamName, &key.TenantID, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.APIKey{}, usecase.ErrAPIKeyNotFound
+		}
+		return entity.APIKey{}, fmt.Errorf("get api key: %w", err)
+	}
+	key.Role = entity.Role(role)
+
+	return key, nil
+}
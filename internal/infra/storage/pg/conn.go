@@ -0,0 +1,173 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+)
+
+// DefaultTenantRole — роль без BYPASSRLS из миграции 011: под ней действуют политики арендаторов
+const DefaultTenantRole = "pr_reviewer_tenant"
+
+// Open открывает пул, в котором данные разделены по арендаторам: перед запросом соединение выставляет
+// app.tenant_id из ctx (tenant.ID), а при подключении переключается на role, чтобы row-level security
+// действовала, даже если сервис входит суперпользователем или владельцем таблиц.
+// Пустая role — не переключаться: так можно, только если RLS подчиняется сама роль входа.
+func Open(dsn, role string) (*sql.DB, error) {
+	c, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	return sql.OpenDB(&tenantConnector{next: c, role: role}), nil
+}
+
+type tenantConnector struct {
+	next driver.Connector
+	role string
+}
+
+func (c *tenantConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.next.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pc, ok := conn.(pqConn)
+	if !ok {
+		_ = conn.Close()
+		return nil, fmt.Errorf("unexpected driver connection %T", conn)
+	}
+	return &tenantConn{pqConn: pc, role: c.role}, nil
+}
+
+func (c *tenantConnector) Driver() driver.Driver {
+	return c.next.Driver()
+}
+
+// pqConn — то, что соединение pq умеет сверх driver.Conn и чем пользуется database/sql
+type pqConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+// tenantConn выставляет роль и арендатора перед первым запросом, а потом — только когда арендатор
+// меняется: и то и другое живёт до конца сессии. Роль ставится не при подключении: до миграции 011
+// её ещё нет, а пулом пользуются и сами миграции.
+type tenantConn struct {
+	pqConn
+	role    string
+	roleSet bool
+	// tenant — арендатор, выставленный в сессии; пусто — неизвестен
+	tenant string
+	// unscoped — соединение отдано миграциям: без роли и арендатора, в пул не возвращается
+	unscoped bool
+}
+
+func (c *tenantConn) scope(ctx context.Context) error {
+	if c.unscoped {
+		return nil
+	}
+	if c.role != "" && !c.roleSet {
+		if _, err := c.pqConn.ExecContext(ctx, "SET ROLE "+pq.QuoteIdentifier(c.role), nil); err != nil {
+			return fmt.Errorf("set role %s: %w", c.role, err)
+		}
+		c.roleSet = true
+	}
+	id := tenant.ID(ctx)
+	if id == c.tenant {
+		return nil
+	}
+	_, err := c.pqConn.ExecContext(ctx, `SELECT set_config('app.tenant_id', $1, false)`,
+		[]driver.NamedValue{{Ordinal: 1, Value: id}})
+	if err != nil {
+		return fmt.Errorf("set tenant: %w", err)
+	}
+	c.tenant = id
+	return nil
+}
+
+func (c *tenantConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.ExecContext(ctx, query, args)
+}
+
+func (c *tenantConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.QueryContext(ctx, query, args)
+}
+
+func (c *tenantConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.PrepareContext(ctx, query)
+}
+
+func (c *tenantConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *tenantConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	tx, err := c.pqConn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantTx{Tx: tx, conn: c}, nil
+}
+
+func (c *tenantConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *tenantConn) ResetSession(ctx context.Context) error {
+	if c.unscoped {
+		return driver.ErrBadConn
+	}
+	return c.pqConn.ResetSession(ctx)
+}
+
+func (c *tenantConn) IsValid() bool {
+	return !c.unscoped && c.pqConn.IsValid()
+}
+
+// tenantTx — откат отменяет и set_config, выполненный внутри транзакции
+type tenantTx struct {
+	driver.Tx
+	conn *tenantConn
+}
+
+func (t *tenantTx) Rollback() error {
+	t.conn.tenant = ""
+	return t.Tx.Rollback()
+}
+
+// unscope снимает с соединения роль арендатора для миграций: им нужны права владельца таблиц.
+// Соединения пула из sql.Open не трогает.
+func unscope(driverConn any) error {
+	c, ok := driverConn.(*tenantConn)
+	if !ok {
+		return nil
+	}
+	if _, err := c.pqConn.ExecContext(context.Background(), `RESET ROLE`, nil); err != nil {
+		return fmt.Errorf("reset role: %w", err)
+	}
+	c.unscoped = true
+	return nil
+}
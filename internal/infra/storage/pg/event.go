@@ -0,0 +1,178 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/lib/pq"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+)
+
+// eventsLockKey — первая половина ключа advisory-lock, сериализующего запись событий арендатора
+// (вторая — hashtext(tenant_id)). Без него транзакции могут закоммититься не в порядке выданных id,
+// и клиент, переподключившийся с Last-Event-ID, пропустит событие. Клиент видит только события своего
+// арендатора, поэтому порядок нужен внутри арендатора, и разные арендаторы пишут параллельно.
+// Двухчастные ключи не пересекаются с одночастными 7_261_00x.
+const eventsLockKey = 7_261_001
+
+type EventStorage struct {
+	db *sql.DB
+}
+
+func NewEventStorage(db *sql.DB) repository.EventRepository {
+	return &EventStorage{db: db}
+}
+
+func (s *EventStorage) getQuerier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok && tx != nil {
+		return tx
+	}
+	return s.db
+}
+
+func (s *EventStorage) Append(ctx context.Context, events []entity.Event) ([]entity.Event, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	q := s.getQuerier(ctx)
+
+	// Лок держится до конца транзакции (вне транзакции — до конца оператора)
+	if _, err := q.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1, hashtext(current_tenant()))`, eventsLockKey); err != nil {
+		return nil, fmt.Errorf("append events: lock: %w", err)
+	}
+
+	types := make([]string, 0, len(events))
+	prIDs := make([]string, 0, len(events))
+	authorIDs := make([]string, 0, len(events))
+	teamNames := make([]string, 0, len(events))
+	reviewerIDs := make([]string, 0, len(events))
+
+	for _, e := range events {
+		types = append(types, string(e.Type))
+		prIDs = append(prIDs, e.PRID)
+		authorIDs = append(authorIDs, e.AuthorID)
+		teamNames = append(teamNames, e.TeamName)
+		reviewerIDs = append(reviewerIDs, e.ReviewerID)
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		INSERT INTO pr_events (type, pr_id, author_id, team_name, reviewer_id)
+		SELECT
+			unnest($1::text[]),
+			unnest($2::text[]),
+			unnest($3::text[]),
+			unnest($4::text[]),
+			unnest($5::text[])
+		RETURNING id, type, pr_id, author_id, team_name, reviewer_id, tenant_id, created_at
+	`,
+		pq.Array(types),
+		pq.Array(prIDs),
+		pq.Array(authorIDs),
+		pq.Array(teamNames),
+		pq.Array(reviewerIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("append events: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	stored, err := scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("append events: %w", err)
+	}
+
+	// RETURNING не гарантирует порядок строк
+	sort.Slice(stored, func(i, j int) bool { return stored[i].ID < stored[j].ID })
+
+	return stored, nil
+}
+
+func (s *EventStorage) ListAfter(ctx context.Context, afterID int64, filter entity.EventFilter, limit int) ([]entity.Event, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT id, type, pr_id, author_id, team_name, reviewer_id, tenant_id, created_at
+		FROM pr_events
+		WHERE id > $1
+		  AND ($2 = '' OR team_name = $2)
+		  AND ($3 = '' OR author_id = $3 OR reviewer_id = $3)
+		ORDER BY id
+		LIMIT $4
+	`, afterID, filter.TeamName, filter.UserID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list events: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	events, err := scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (s *EventStorage) Cursor(ctx context.Context, name string) (int64, error) {
+	q := s.getQuerier(ctx)
+
+	// Основной запрос CTE не видит строку, вставленную в том же операторе, поэтому UNION ALL
+	// отдаёт либо новую позицию, либо существующую
+	var lastID int64
+	err := q.QueryRowContext(ctx, `
+		WITH created AS (
+			INSERT INTO event_cursors (name, last_event_id)
+			SELECT $1, COALESCE(MAX(id), 0) FROM pr_events
+			ON CONFLICT (tenant_id, name) DO NOTHING
+			RETURNING last_event_id
+		)
+		SELECT last_event_id FROM created
+		UNION ALL
+		SELECT last_event_id FROM event_cursors WHERE name = $1
+		LIMIT 1
+	`, name).Scan(&lastID)
+	if err != nil {
+		return 0, fmt.Errorf("get event cursor: %w", err)
+	}
+	return lastID, nil
+}
+
+func (s *EventStorage) SaveCursor(ctx context.Context, name string, lastID int64) error {
+	q := s.getQuerier(ctx)
+
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO event_cursors (name, last_event_id)
+		VALUES ($1, $2)
+		ON CONFLICT (tenant_id, name) DO UPDATE
+		SET last_event_id = GREATEST(event_cursors.last_event_id, EXCLUDED.last_event_id),
+		    updated_at = now()
+	`, name, lastID)
+	if err != nil {
+		return fmt.Errorf("save event cursor: %w", err)
+	}
+	return nil
+}
+
+func scanEvents(rows *sql.Rows) ([]entity.Event, error) {
+	var events []entity.Event
+	for rows.Next() {
+		var e entity.Event
+		var typeStr string
+
+		if err := rows.Scan(&e.ID, &typeStr, &e.PRID, &e.AuthorID, &e.TeamName, &e.ReviewerID, &e.TenantID, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+		e.Type = entity.EventType(typeStr)
+
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
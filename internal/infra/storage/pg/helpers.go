@@ -1,14 +1,31 @@
 package pg
 
 import (
+	"context"
 	"database/sql"
-	"log"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark47B/be-internship/internal/domain/usecase"
 )
 
-func CloseRows(rows *sql.Rows) {
+func CloseRows(ctx context.Context, rows *sql.Rows) {
 	if rows != nil {
 		if err := rows.Close(); err != nil {
-			log.Printf("ERROR closing rows: %v", err)
+			slog.ErrorContext(ctx, "close rows", "error", err)
 		}
 	}
 }
+
+// checkVersionUpdated — UPDATE ... WHERE version = $n не затронул строк: версия уже изменилась
+// (или строки нет — вызывающий перед этим читал её в той же транзакции)
+func checkVersionUpdated(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return usecase.ErrVersionMismatch
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+type IdempotencyStorage struct {
+	db *sql.DB
+}
+
+func NewIdempotencyStorage(db *sql.DB) repository.IdempotencyRepository {
+	return &IdempotencyStorage{db: db}
+}
+
+func (s *IdempotencyStorage) getQuerier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok && tx != nil {
+		return tx
+	}
+	return s.db
+}
+
+func (s *IdempotencyStorage) Claim(ctx context.Context, rec entity.IdempotencyRecord, ttl, lease time.Duration) (entity.IdempotencyRecord, bool, error) {
+	q := s.getQuerier(ctx)
+
+	// Вторая попытка нужна, если запись истекла/удалилась между INSERT и SELECT
+	for attempt := 0; attempt < 2; attempt++ {
+		var claimed bool
+		err := q.QueryRowContext(ctx, `
+			INSERT INTO idempotency_keys (scope, key, request_hash, expires_at, locked_until)
+			VALUES ($1, $2, $3, now() + $4::float8 * interval '1 second', now() + $5::float8 * interval '1 second')
+			ON CONFLICT (tenant_id, scope, key) DO UPDATE SET
+				request_hash = EXCLUDED.request_hash,
+				status_code = NULL,
+				response_headers = '{}',
+				response_body = NULL,
+				created_at = now(),
+				expires_at = EXCLUDED.expires_at,
+				locked_until = EXCLUDED.locked_until
+			WHERE idempotency_keys.expires_at < now()
+			   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until < now())
+			RETURNING true
+		`, rec.Scope, rec.Key, rec.RequestHash, ttl.Seconds(), lease.Seconds()).Scan(&claimed)
+		if err == nil {
+			return entity.IdempotencyRecord{}, true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return entity.IdempotencyRecord{}, false, fmt.Errorf("claim idempotency key: %w", err)
+		}
+
+		existing, err := s.get(ctx, q, rec.Scope, rec.Key)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return entity.IdempotencyRecord{}, false, err
+		}
+		return existing, false, nil
+	}
+
+	return entity.IdempotencyRecord{}, false, usecase.ErrConflict
+}
+
+func (s *IdempotencyStorage) get(ctx context.Context, q Querier, scope, key string) (entity.IdempotencyRecord, error) {
+	rec := entity.IdempotencyRecord{Scope: scope, Key: key}
+	var status sql.NullInt64
+	var header []byte
+
+	err := q.QueryRowContext(ctx, `
+		SELECT request_hash, status_code, response_headers, response_body, expires_at
+		FROM idempotency_keys
+		WHERE scope = $1 AND key = $2
+	`, scope, key).Scan(&rec.RequestHash, &status, &header, &rec.Body, &rec.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.IdempotencyRecord{}, err
+		}
+		return entity.IdempotencyRecord{}, fmt.Errorf("get idempotency key: %w", err)
+	}
+	rec.StatusCode = int(status.Int64)
+	if err := json.Unmarshal(header, &rec.Header); err != nil {
+		return entity.IdempotencyRecord{}, fmt.Errorf("get idempotency key: headers: %w", err)
+	}
+
+	return rec, nil
+}
+
+func (s *IdempotencyStorage) Complete(ctx context.Context, rec entity.IdempotencyRecord) error {
+	q := s.getQuerier(ctx)
+
+	header, err := json.Marshal(rec.Header)
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: headers: %w", err)
+	}
+	_, err = q.ExecContext(ctx, `
+		UPDATE idempotency_keys
+		SET status_code = $3, response_headers = $4, response_body = $5, locked_until = NULL
+		WHERE scope = $1 AND key = $2
+	`, rec.Scope, rec.Key, rec.StatusCode, header, rec.Body)
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *IdempotencyStorage) Release(ctx context.Context, scope, key string) error {
+	q := s.getQuerier(ctx)
+
+	_, err := q.ExecContext(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE scope = $1 AND key = $2 AND status_code IS NULL
+	`, scope, key)
+	if err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *IdempotencyStorage) DeleteExpired(ctx context.Context) (int64, error) {
+	q := s.getQuerier(ctx)
+
+	res, err := q.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at < now()`)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,82 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// StaleLockKey — ключ лидерства планировщика зависших PR
+const StaleLockKey = 7_261_003
+
+// NotifyLockKey — ключ лидерства рассылки уведомлений о назначениях (7_261_004 занят иерархией команд)
+const NotifyLockKey = 7_261_005
+
+// LeaderLock — лидерство среди реплик на session-level advisory lock. Лидер держит отдельное
+// соединение из пула: пока сессия жива, лок за ним; упала реплика или соединение — лок
+// освобождает сам Postgres, и его забирает следующая реплика.
+type LeaderLock struct {
+	db  *sql.DB
+	key int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+func NewLeaderLock(db *sql.DB, key int64) *LeaderLock {
+	return &LeaderLock{db: db, key: key}
+}
+
+// TryLead не ждёт: true — эта реплика лидер (в том числе осталась им с прошлого вызова)
+func (l *LeaderLock) TryLead(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		// Лок живёт, пока живо соединение
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		_ = l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("leader lock: conn: %w", err)
+	}
+	var ok bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&ok); err != nil {
+		_ = conn.Close()
+		return false, fmt.Errorf("leader lock: %w", err)
+	}
+	if !ok {
+		_ = conn.Close()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Release отдаёт лидерство; без лидерства — ничего не делает
+func (l *LeaderLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+	conn := l.conn
+	l.conn = nil
+
+	_, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, l.key)
+	if err != nil {
+		// Закрытое соединение вернулось бы в пул вместе с локом: помечаем его битым, и пул его выбросит
+		_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		err = fmt.Errorf("leader lock: unlock: %w", err)
+	}
+	return errors.Join(err, conn.Close())
+}
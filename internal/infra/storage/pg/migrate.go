@@ -0,0 +1,93 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgmigrate "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// Сколько реплика ждёт, пока другая применяет миграции
+const migrationLockTimeout = 5 * time.Minute
+
+// Migrator применяет миграции из fs.FS (вшитые в бинарник).
+// На время up/down драйвер golang-migrate держит pg_advisory_lock, поэтому
+// реплики, стартующие одновременно, применяют миграции по очереди, а не наперегонки.
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+// NewMigrator берёт из пула отдельное соединение: advisory lock живёт на уровне сессии.
+// Соединение пула из Open миграции получают без роли арендатора, и в пул оно уже не возвращается.
+func NewMigrator(ctx context.Context, db *sql.DB, source fs.FS) (*Migrator, error) {
+	src, err := iofs.New(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("migrations source: %w", err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrations conn: %w", err)
+	}
+	if err := conn.Raw(unscope); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("migrations conn: %w", err)
+	}
+	driver, err := pgmigrate.WithConnection(ctx, conn, &pgmigrate.Config{})
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("migrations driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+	m.LockTimeout = migrationLockTimeout
+
+	return &Migrator{m: m}, nil
+}
+
+// Up применяет все новые миграции; схема уже актуальна — не ошибка
+func (m *Migrator) Up() error {
+	if err := m.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migrate up: %w", err)
+	}
+	return nil
+}
+
+// Down откатывает steps последних миграций
+func (m *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("migrate down: steps must be positive, got %d", steps)
+	}
+	if err := m.m.Steps(-steps); err != nil {
+		return fmt.Errorf("migrate down: %w", err)
+	}
+	return nil
+}
+
+// Version — применённая версия; 0, если миграций ещё не было
+func (m *Migrator) Version() (version uint, dirty bool, err error) {
+	version, dirty, err = m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("migrate version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+// Close возвращает соединение в пул; сам пул остаётся открытым
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
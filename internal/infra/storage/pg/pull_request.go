@@ -5,7 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/lib/pq"
@@ -47,7 +47,7 @@ func (s *PullRequestStorage) Save(ctx context.Context, pr entity.PullRequest) er
 	_, err := q.ExecContext(ctx, `
 		INSERT INTO pull_requests (id, name, author_id, status, created_at, merged_at)
 		VALUES ($1, $2, $3, $4, $5, $6)
-		ON CONFLICT (id) DO UPDATE SET
+		ON CONFLICT (tenant_id, id) DO UPDATE SET
 			name = EXCLUDED.name,
 			author_id = EXCLUDED.author_id,
 			status = EXCLUDED.status,
@@ -68,10 +68,10 @@ func (s *PullRequestStorage) Get(ctx context.Context, id string) (entity.PullReq
 	var statusStr string
 
 	err := q.QueryRowContext(ctx, `
-		SELECT id, name, author_id, status, created_at, merged_at
+		SELECT id, name, author_id, status, created_at, merged_at, version
 		FROM pull_requests
 		WHERE id = $1
-	`, id).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt)
+	`, id).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt, &pr.Version)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return entity.PullRequest{}, usecase.ErrPRNotFound
@@ -99,7 +99,7 @@ func (s *PullRequestStorage) GetByReviewer(ctx context.Context, reviewerID strin
 	q := s.getQuerier(ctx)
 
 	rows, err := q.QueryContext(ctx, `
-		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version
 		FROM pull_requests pr
 		INNER JOIN review_assignments ra ON pr.id = ra.pr_id
 		WHERE ra.reviewer_id = $1
@@ -108,7 +108,7 @@ func (s *PullRequestStorage) GetByReviewer(ctx context.Context, reviewerID strin
 	if err != nil {
 		return nil, fmt.Errorf("get PRs by reviewer: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
 	var prs []entity.PullRequest
 	for rows.Next() {
@@ -117,7 +117,7 @@ func (s *PullRequestStorage) GetByReviewer(ctx context.Context, reviewerID strin
 		var mergedAt sql.NullTime
 		var statusStr string
 
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt); err != nil {
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt, &pr.Version); err != nil {
 			return nil, fmt.Errorf("scan PR: %w", err)
 		}
 
@@ -152,15 +152,15 @@ func (s *PullRequestStorage) Update(ctx context.Context, pr entity.PullRequest)
 		mergedAt = sql.NullTime{Time: *pr.MergedAt, Valid: true}
 	}
 
-	_, err := q.ExecContext(ctx, `
+	res, err := q.ExecContext(ctx, `
 		UPDATE pull_requests
-		SET name = $2, author_id = $3, status = $4, merged_at = $5
-		WHERE id = $1
-	`, pr.ID, pr.Name, pr.AuthorID, string(pr.Status), mergedAt)
+		SET name = $2, author_id = $3, status = $4, merged_at = $5, version = version + 1
+		WHERE id = $1 AND version = $6
+	`, pr.ID, pr.Name, pr.AuthorID, string(pr.Status), mergedAt, pr.Version)
 	if err != nil {
 		return fmt.Errorf("update pull request: %w", err)
 	}
-	return nil
+	return checkVersionUpdated(res)
 }
 
 func (s *PullRequestStorage) GetReviewers(ctx context.Context, prID string) ([]string, error) {
@@ -175,9 +175,10 @@ func (s *PullRequestStorage) GetReviewers(ctx context.Context, prID string) ([]s
 	if err != nil {
 		return nil, fmt.Errorf("get reviewers: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
-	var reviewers []string
+	// Пустой список, а не nil: в API assigned_reviewers — обязательный массив
+	reviewers := []string{}
 	for rows.Next() {
 		var reviewerID string
 		if err := rows.Scan(&reviewerID); err != nil {
@@ -203,7 +204,7 @@ func (s *PullRequestStorage) AssignReviewers(ctx context.Context, prID string, r
 	query := `
 		INSERT INTO review_assignments (pr_id, reviewer_id)
 		SELECT $1, unnest($2::text[])
-		ON CONFLICT (pr_id, reviewer_id) DO NOTHING
+		ON CONFLICT (tenant_id, pr_id, reviewer_id) DO NOTHING
 	`
 
 	_, err := q.ExecContext(ctx, query, prID, pq.Array(reviewerIDs))
@@ -230,7 +231,7 @@ func (s *PullRequestStorage) ReplaceReviewer(ctx context.Context, prID, oldRevie
 	_, err = q.ExecContext(ctx, `
 		INSERT INTO review_assignments (pr_id, reviewer_id)
 		VALUES ($1, $2)
-		ON CONFLICT (pr_id, reviewer_id) DO NOTHING
+		ON CONFLICT (tenant_id, pr_id, reviewer_id) DO NOTHING
 	`, prID, newReviewerID)
 	if err != nil {
 		return fmt.Errorf("add new reviewer: %w", err)
@@ -251,57 +252,176 @@ func (s *PullRequestStorage) RemoveReviewer(ctx context.Context, prID, reviewerI
 	return nil
 }
 
-func (s *PullRequestStorage) GetStats(ctx context.Context) (entity.PRStats, error) {
+func (s *PullRequestStorage) ApplyReviewerChanges(ctx context.Context, changes []entity.ReviewerChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	q := s.getQuerier(ctx)
+
+	prIDs := make([]string, 0, len(changes))
+	oldIDs := make([]string, 0, len(changes))
+	var addPRIDs, newIDs []string
+	for _, c := range changes {
+		prIDs = append(prIDs, c.PRID)
+		oldIDs = append(oldIDs, c.OldReviewerID)
+		if c.NewReviewerID != "" {
+			addPRIDs = append(addPRIDs, c.PRID)
+			newIDs = append(newIDs, c.NewReviewerID)
+		}
+	}
+
+	// Сначала удаляем все старые назначения: триггер считает ревьюверов при вставке,
+	// и на PR с двумя ревьюверами новый иначе не поместится
+	_, err := q.ExecContext(ctx, `
+		DELETE FROM review_assignments ra
+		USING unnest($1::text[], $2::text[]) AS c(pr_id, reviewer_id)
+		WHERE ra.pr_id = c.pr_id AND ra.reviewer_id = c.reviewer_id
+	`, pq.Array(prIDs), pq.Array(oldIDs))
+	if err != nil {
+		return fmt.Errorf("apply reviewer changes: remove: %w", err)
+	}
+
+	if len(newIDs) == 0 {
+		return nil
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO review_assignments (pr_id, reviewer_id)
+		SELECT * FROM unnest($1::text[], $2::text[])
+		ON CONFLICT (tenant_id, pr_id, reviewer_id) DO NOTHING
+	`, pq.Array(addPRIDs), pq.Array(newIDs))
+	if err != nil {
+		return fmt.Errorf("apply reviewer changes: add: %w", err)
+	}
+	return nil
+}
+
+func (s *PullRequestStorage) BumpVersions(ctx context.Context, prs []entity.PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	q := s.getQuerier(ctx)
+
+	ids := make([]string, 0, len(prs))
+	versions := make([]int64, 0, len(prs))
+	for _, pr := range prs {
+		ids = append(ids, pr.ID)
+		versions = append(versions, pr.Version)
+	}
+
+	res, err := q.ExecContext(ctx, `
+		UPDATE pull_requests pr
+		SET version = pr.version + 1
+		FROM unnest($1::text[], $2::bigint[]) AS v(id, version)
+		WHERE pr.id = v.id AND pr.version = v.version
+	`, pq.Array(ids), pq.Array(versions))
+	if err != nil {
+		return fmt.Errorf("bump pull request versions: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n != int64(len(prs)) {
+		return usecase.ErrVersionMismatch
+	}
+	return nil
+}
+
+func (s *PullRequestStorage) GetStats(ctx context.Context, filter entity.StatsFilter) (entity.PRStats, error) {
 	q := s.getQuerier(ctx)
 
 	var stats entity.PRStats
-	var avgReviewers sql.NullFloat64
+	var mergeQuantiles, firstReviewQuantiles pq.Float64Array
 
+	// Время до первого ревьювера считаем по журналу: оба момента — now() базы, а created_at PR пишется
+	// часами приложения. Назначение в транзакции создания даёт ноль, PR без кандидатов ждёт эскалации
+	// или ручного переназначения; PR без назначений в выборку не попадает
 	err := q.QueryRowContext(ctx, `
+		WITH prs AS (
+			SELECT pr.id, pr.status, pr.created_at, pr.merged_at
+			FROM pull_requests pr
+			LEFT JOIN users u ON u.id = pr.author_id
+			WHERE ($1 = '' OR u.team_name = $1 OR ($4 AND u.team_name IN (SELECT team_subtree($1))))
+			  AND ($2::timestamp IS NULL OR pr.created_at >= $2)
+			  AND ($3::timestamp IS NULL OR pr.created_at < $3)
+		),
+		merge_hours AS (
+			SELECT EXTRACT(EPOCH FROM merged_at - created_at) / 3600 AS h
+			FROM prs
+			WHERE status = 'MERGED' AND merged_at IS NOT NULL
+		),
+		created AS (
+			SELECT e.pr_id, MIN(e.created_at) AS at
+			FROM pr_events e
+			JOIN prs ON prs.id = e.pr_id
+			WHERE e.type = 'PR_CREATED'
+			GROUP BY e.pr_id
+		),
+		first_review_hours AS (
+			SELECT EXTRACT(EPOCH FROM MIN(e.created_at) - c.at) / 3600 AS h
+			FROM created c
+			JOIN pr_events e ON e.pr_id = c.pr_id AND e.type = 'REVIEWER_ASSIGNED' AND e.created_at >= c.at
+			GROUP BY c.pr_id, c.at
+		)
 		SELECT
-			COUNT(*) as total,
-			COUNT(*) FILTER (WHERE status = 'OPEN') as open,
-			COUNT(*) FILTER (WHERE status = 'MERGED') as merged,
-			COALESCE(AVG(reviewer_count), 0) as avg_reviewers
-		FROM pull_requests pr
-		LEFT JOIN (
-			SELECT pr_id, COUNT(*) as reviewer_count
-			FROM review_assignments
-			GROUP BY pr_id
-		) ra ON pr.id = ra.pr_id
-	`).Scan(&stats.Total, &stats.Open, &stats.Merged, &avgReviewers)
+			(SELECT COUNT(*) FROM prs),
+			(SELECT COUNT(*) FROM prs WHERE status = 'OPEN'),
+			(SELECT COUNT(*) FROM prs WHERE status = 'MERGED'),
+			(SELECT COALESCE(AVG(cnt), 0) FROM (
+				SELECT COUNT(*) AS cnt
+				FROM review_assignments ra
+				JOIN prs ON prs.id = ra.pr_id
+				GROUP BY ra.pr_id
+			) r),
+			(SELECT COUNT(*) FROM merge_hours),
+			(SELECT COALESCE(AVG(h), 0) FROM merge_hours),
+			(SELECT percentile_cont(ARRAY[0.5, 0.9, 0.99]) WITHIN GROUP (ORDER BY h) FROM merge_hours),
+			(SELECT COUNT(h) FROM first_review_hours),
+			(SELECT COALESCE(AVG(h), 0) FROM first_review_hours),
+			(SELECT percentile_cont(ARRAY[0.5, 0.9, 0.99]) WITHIN GROUP (ORDER BY h) FROM first_review_hours)
+	`, filter.TeamName, filter.From, filter.To, filter.Subteams).Scan(
+		&stats.Total, &stats.Open, &stats.Merged, &stats.AvgReviewers,
+		&stats.MergeTime.Count, &stats.MergeTime.AvgHours, &mergeQuantiles,
+		&stats.FirstReviewTime.Count, &stats.FirstReviewTime.AvgHours, &firstReviewQuantiles,
+	)
 	if err != nil {
 		return entity.PRStats{}, fmt.Errorf("get PR stats: %w", err)
 	}
 
-	if avgReviewers.Valid {
-		stats.AvgReviewers = avgReviewers.Float64
-	}
-
+	setQuantiles(&stats.MergeTime, mergeQuantiles)
+	setQuantiles(&stats.FirstReviewTime, firstReviewQuantiles)
+	stats.AvgMergeTimeHours = stats.MergeTime.AvgHours
 	return stats, nil
 }
 
+// setQuantiles раскладывает percentile_cont(ARRAY[0.5, 0.9, 0.99]); на пустой выборке там NULL
+func setQuantiles(d *entity.DurationStats, q pq.Float64Array) {
+	if len(q) != 3 {
+		return
+	}
+	d.MedianHours, d.P90Hours, d.P99Hours = q[0], q[1], q[2]
+}
+
 func (s *PullRequestStorage) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) ([]entity.PullRequest, error) {
-	log.Println("[DEBUG] GetOpenPRsByReviewers: start, reviewerIDs:", reviewerIDs)
 	if len(reviewerIDs) == 0 {
 		return []entity.PullRequest{}, nil
 	}
 
 	q := s.getQuerier(ctx)
-	log.Println("[DEBUG] GetOpenPRsByReviewers: got querier")
 
 	rows, err := q.QueryContext(ctx, `
-		SELECT DISTINCT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		SELECT DISTINCT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version
 		FROM pull_requests pr
 		INNER JOIN review_assignments ra ON pr.id = ra.pr_id
 		WHERE ra.reviewer_id = ANY($1::text[]) AND pr.status = 'OPEN'
 		ORDER BY pr.created_at DESC
 	`, pq.Array(reviewerIDs))
 	if err != nil {
-		log.Printf("[DEBUG] GetOpenPRsByReviewers: query error: %v", err)
 		return nil, fmt.Errorf("get open PRs by reviewers: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
 	var prs []entity.PullRequest
 	for rows.Next() {
@@ -310,8 +430,7 @@ func (s *PullRequestStorage) GetOpenPRsByReviewers(ctx context.Context, reviewer
 		var mergedAt sql.NullTime
 		var statusStr string
 
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt); err != nil {
-			log.Printf("[DEBUG] GetOpenPRsByReviewers: scan error: %v", err)
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt, &pr.Version); err != nil {
 			return nil, fmt.Errorf("scan PR: %w", err)
 		}
 
@@ -324,78 +443,53 @@ func (s *PullRequestStorage) GetOpenPRsByReviewers(ctx context.Context, reviewer
 		// НЕ загружаем reviewers здесь, так как это может быть внутри транзакции
 		// Reviewers будут загружены позже при необходимости через GetReviewers в service.go
 		pr.Reviewers = []string{}
-		log.Printf("[DEBUG] GetOpenPRsByReviewers: added PR %s without reviewers", pr.ID)
 
 		prs = append(prs, pr)
 	}
 
 	if err := rows.Err(); err != nil {
-		log.Printf("[DEBUG] GetOpenPRsByReviewers: rows error: %v", err)
 		return nil, err
 	}
 
-	log.Printf("[DEBUG] GetOpenPRsByReviewers: returning %d PRs", len(prs))
+	slog.DebugContext(ctx, "open PRs by reviewers", "reviewers", len(reviewerIDs), "prs", len(prs))
 	return prs, nil
 }
 
-func (s *PullRequestStorage) GetOpenPRsByTeam(ctx context.Context, teamName string) ([]entity.PullRequest, error) {
+func (s *PullRequestStorage) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	result := make(map[string]int, len(userIDs))
+	for _, id := range userIDs {
+		result[id] = 0
+	}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
 	q := s.getQuerier(ctx)
 
 	rows, err := q.QueryContext(ctx, `
-        SELECT
-            pr.id,
-            pr.name,
-            pr.author_id,
-            pr.status,
-            pr.created_at,
-            pr.merged_at
-        FROM pull_requests pr
-        INNER JOIN users u ON pr.author_id = u.id
-        WHERE u.team_name = $1
-          AND u.is_active = true      -- только активные авторы (опционально, но логично)
-          AND pr.status = 'OPEN'
-        ORDER BY pr.created_at DESC
-    `, teamName)
+		SELECT ra.reviewer_id, COUNT(*)
+		FROM review_assignments ra
+		JOIN pull_requests pr ON pr.id = ra.pr_id
+		WHERE ra.reviewer_id = ANY($1::text[]) AND pr.status = 'OPEN'
+		GROUP BY ra.reviewer_id
+	`, pq.Array(userIDs))
 	if err != nil {
-		return nil, fmt.Errorf("get open PRs by team: query: %w", err)
+		return nil, fmt.Errorf("count open reviews: query: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
-	var prs []entity.PullRequest
 	for rows.Next() {
-		var pr entity.PullRequest
-		var createdAt time.Time
-		var mergedAt sql.NullTime
-		var statusStr string
-
-		if err := rows.Scan(
-			&pr.ID,
-			&pr.Name,
-			&pr.AuthorID,
-			&statusStr,
-			&createdAt,
-			&mergedAt,
-		); err != nil {
-			return nil, fmt.Errorf("get open PRs by team: scan: %w", err)
+		var id string
+		var n int
+		if err := rows.Scan(&id, &n); err != nil {
+			return nil, fmt.Errorf("count open reviews: scan: %w", err)
 		}
-
-		pr.Status = entity.PRStatus(statusStr)
-		pr.CreatedAt = &createdAt
-		if mergedAt.Valid {
-			pr.MergedAt = &mergedAt.Time
-		}
-
-		// НЕ загружаем ревьюверов здесь!
-		pr.Reviewers = []string{}
-
-		prs = append(prs, pr)
+		result[id] = n
 	}
-
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("get open PRs by team: rows error: %w", err)
+		return nil, fmt.Errorf("count open reviews: rows error: %w", err)
 	}
-
-	return prs, nil
+	return result, nil
 }
 
 func (s *PullRequestStorage) GetReviewersBatch(ctx context.Context, prIDs []string) (map[string][]string, error) {
@@ -415,7 +509,7 @@ func (s *PullRequestStorage) GetReviewersBatch(ctx context.Context, prIDs []stri
 	if err != nil {
 		return nil, fmt.Errorf("get reviewers batch: query: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
 	result := make(map[string][]string, len(prIDs))
 
@@ -438,3 +532,143 @@ func (s *PullRequestStorage) GetReviewersBatch(ctx context.Context, prIDs []stri
 
 	return result, nil
 }
+
+func (s *PullRequestStorage) List(ctx context.Context, afterID string, limit int) ([]entity.PullRequest, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+        SELECT id, name, author_id, status, created_at, merged_at, version
+        FROM pull_requests
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2
+    `, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list PRs: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	prs := make([]entity.PullRequest, 0, limit)
+	for rows.Next() {
+		var pr entity.PullRequest
+		var createdAt time.Time
+		var mergedAt sql.NullTime
+		var statusStr string
+
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &createdAt, &mergedAt, &pr.Version); err != nil {
+			return nil, fmt.Errorf("list PRs: scan: %w", err)
+		}
+
+		pr.Status = entity.PRStatus(statusStr)
+		pr.CreatedAt = &createdAt
+		if mergedAt.Valid {
+			pr.MergedAt = &mergedAt.Time
+		}
+		pr.Reviewers = []string{}
+
+		prs = append(prs, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list PRs: rows error: %w", err)
+	}
+
+	return prs, nil
+}
+
+// slaColumn — SLA команды автора (join team_sla s), а если у команды его нет — значение по умолчанию
+func slaColumn(column, defaultParam string) string {
+	return "CASE WHEN s.team_name IS NULL THEN make_interval(secs => " + defaultParam + ") ELSE s." + column + " END"
+}
+
+func (s *PullRequestStorage) ListStale(ctx context.Context, now time.Time, def entity.SLA) ([]entity.StalePR, error) {
+	q := s.getQuerier(ctx)
+
+	remindAfter := slaColumn("remind_after", "$2")
+	rows, err := q.QueryContext(ctx, `
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.version, COALESCE(u.team_name, ''),
+			EXTRACT(EPOCH FROM `+remindAfter+`)::float8, EXTRACT(EPOCH FROM `+slaColumn("reassign_after", "$3")+`)::float8,
+			COALESCE(array_agg(ra.reviewer_id ORDER BY ra.reviewer_id) FILTER (WHERE ra.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		LEFT JOIN users u ON u.id = pr.author_id
+		LEFT JOIN team_sla s ON s.team_name = u.team_name
+		LEFT JOIN review_assignments ra ON ra.pr_id = pr.id
+		WHERE pr.status = 'OPEN'
+		  AND pr.created_at <= $1::timestamp - `+remindAfter+`
+		  AND (pr.reminded_at IS NULL OR pr.reminded_at <= $1::timestamp - `+remindAfter+`)
+		GROUP BY pr.tenant_id, pr.id, u.team_name, s.tenant_id, s.team_name
+		ORDER BY pr.created_at, pr.id
+	`, now, def.RemindAfter.Seconds(), def.ReassignAfter.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("list stale PRs: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	prs := []entity.StalePR{}
+	for rows.Next() {
+		var pr entity.StalePR
+		var createdAt time.Time
+		var status string
+		var remind, reassign float64
+		var reviewers pq.StringArray
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &createdAt, &pr.Version, &pr.TeamName,
+			&remind, &reassign, &reviewers); err != nil {
+			return nil, fmt.Errorf("list stale PRs: scan: %w", err)
+		}
+		pr.Status = entity.PRStatus(status)
+		pr.CreatedAt = &createdAt
+		pr.Reviewers = reviewers
+		pr.SLA = entity.SLA{RemindAfter: fromSeconds(remind), ReassignAfter: fromSeconds(reassign)}
+		prs = append(prs, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list stale PRs: rows error: %w", err)
+	}
+	return prs, nil
+}
+
+func (s *PullRequestStorage) ListOverdueReviews(ctx context.Context, now time.Time, def entity.SLA) ([]entity.OverdueReview, error) {
+	q := s.getQuerier(ctx)
+
+	reassignAfter := slaColumn("reassign_after", "$2")
+	rows, err := q.QueryContext(ctx, `
+		SELECT ra.pr_id, ra.reviewer_id, ra.assigned_at
+		FROM review_assignments ra
+		JOIN pull_requests pr ON pr.id = ra.pr_id AND pr.status = 'OPEN'
+		LEFT JOIN users u ON u.id = pr.author_id
+		LEFT JOIN team_sla s ON s.team_name = u.team_name
+		WHERE `+reassignAfter+` > INTERVAL '0'
+		  AND ra.assigned_at <= $1::timestamp - `+reassignAfter+`
+		ORDER BY ra.assigned_at, ra.pr_id, ra.reviewer_id
+	`, now, def.ReassignAfter.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("list overdue reviews: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	reviews := []entity.OverdueReview{}
+	for rows.Next() {
+		var r entity.OverdueReview
+		if err := rows.Scan(&r.PRID, &r.ReviewerID, &r.AssignedAt); err != nil {
+			return nil, fmt.Errorf("list overdue reviews: scan: %w", err)
+		}
+		reviews = append(reviews, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list overdue reviews: rows error: %w", err)
+	}
+	return reviews, nil
+}
+
+// MarkReminded не меняет версию PR: напоминание — не изменение PR
+func (s *PullRequestStorage) MarkReminded(ctx context.Context, prIDs []string, at time.Time) error {
+	if len(prIDs) == 0 {
+		return nil
+	}
+	q := s.getQuerier(ctx)
+
+	_, err := q.ExecContext(ctx, `UPDATE pull_requests SET reminded_at = $2 WHERE id = ANY($1::text[])`, pq.Array(prIDs), at)
+	if err != nil {
+		return fmt.Errorf("mark reminded: %w", err)
+	}
+	return nil
+}
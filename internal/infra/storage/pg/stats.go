@@ -0,0 +1,235 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+)
+
+// rollupLockKey — ключ advisory-lock пересчёта агрегатов: реплики не пересчитывают одни и те же дни
+// наперегонки (DELETE + INSERT параллельно дали бы нарушение первичного ключа)
+const rollupLockKey = 7_261_002
+
+type StatsStorage struct {
+	db *sql.DB
+}
+
+func NewStatsStorage(db *sql.DB) repository.StatsRepository {
+	return &StatsStorage{db: db}
+}
+
+func (s *StatsStorage) getQuerier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok && tx != nil {
+		return tx
+	}
+	return s.db
+}
+
+// Rollup вызывается в транзакции: удаление и вставка за те же дни должны быть атомарны
+func (s *StatsStorage) Rollup(ctx context.Context, from, to time.Time) (int, error) {
+	q := s.getQuerier(ctx)
+
+	if _, err := q.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, rollupLockKey); err != nil {
+		return 0, fmt.Errorf("rollup: lock: %w", err)
+	}
+
+	if _, err := q.ExecContext(ctx, `DELETE FROM daily_stats WHERE day BETWEEN $1::date AND $2::date`, from, to); err != nil {
+		return 0, fmt.Errorf("rollup: delete: %w", err)
+	}
+
+	// Каждый факт — строка с единицей в своей колонке; PR считаются по команде автора,
+	// назначения — по команде из события (тоже команда автора) и по ревьюверу
+	res, err := q.ExecContext(ctx, `
+		WITH facts AS (
+			SELECT pr.created_at::date AS day, COALESCE(u.team_name, '') AS team_name, pr.author_id AS user_id,
+				1 AS opened, 0 AS merged, 0 AS assigned, 0 AS reassigned, NULL::float8 AS merge_h
+			FROM pull_requests pr
+			LEFT JOIN users u ON u.id = pr.author_id
+			WHERE pr.created_at >= $1::date AND pr.created_at < $2::date + 1
+			UNION ALL
+			SELECT pr.merged_at::date, COALESCE(u.team_name, ''), pr.author_id,
+				0, 1, 0, 0, EXTRACT(EPOCH FROM pr.merged_at - pr.created_at) / 3600
+			FROM pull_requests pr
+			LEFT JOIN users u ON u.id = pr.author_id
+			WHERE pr.status = 'MERGED' AND pr.merged_at >= $1::date AND pr.merged_at < $2::date + 1
+			UNION ALL
+			SELECT e.created_at::date, e.team_name, e.reviewer_id,
+				0, 0, (e.type = 'REVIEWER_ASSIGNED')::int, (e.type = 'REVIEWER_UNASSIGNED')::int, NULL
+			FROM pr_events e
+			WHERE e.type IN ('REVIEWER_ASSIGNED', 'REVIEWER_UNASSIGNED')
+			  AND e.created_at >= $1::date AND e.created_at < $2::date + 1
+		)
+		INSERT INTO daily_stats (day, team_name, user_id, prs_opened, prs_merged, reviews_assigned, reassignments, merge_hours)
+		SELECT day, team_name, '', SUM(opened), SUM(merged), SUM(assigned), SUM(reassigned),
+			COALESCE(array_agg(merge_h) FILTER (WHERE merge_h IS NOT NULL), '{}')
+		FROM facts
+		GROUP BY day, team_name
+		UNION ALL
+		SELECT day, '', user_id, SUM(opened), SUM(merged), SUM(assigned), SUM(reassigned),
+			COALESCE(array_agg(merge_h) FILTER (WHERE merge_h IS NOT NULL), '{}')
+		FROM facts
+		WHERE user_id <> ''
+		GROUP BY day, user_id
+	`, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("rollup: insert: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+	return int(n), nil
+}
+
+func (s *StatsStorage) RollupStart(ctx context.Context) (time.Time, bool, error) {
+	q := s.getQuerier(ctx)
+
+	var day sql.NullTime
+	err := q.QueryRowContext(ctx, `
+		SELECT COALESCE(
+			(SELECT MAX(day) FROM daily_stats),
+			LEAST(
+				(SELECT MIN(created_at)::date FROM pull_requests),
+				(SELECT MIN(created_at)::date FROM pr_events)
+			)
+		)
+	`).Scan(&day)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("rollup start: %w", err)
+	}
+	return day.Time, day.Valid, nil
+}
+
+func (s *StatsStorage) Timeseries(ctx context.Context, filter entity.TimeseriesFilter) ([]entity.TimeseriesPoint, error) {
+	q := s.getQuerier(ctx)
+
+	// Пустые интервалы тоже нужны графику, поэтому ряд строится от generate_series
+	rows, err := q.QueryContext(ctx, `
+		WITH buckets AS (
+			SELECT b::date AS start
+			FROM generate_series(date_trunc($1, $2::date::timestamp), ($3::date - 1)::timestamp, ('1 ' || $1)::interval) b
+		),
+		selected AS (
+			SELECT date_trunc($1, day::timestamp)::date AS start, *
+			FROM daily_stats
+			WHERE day >= $2::date AND day < $3::date
+			  AND CASE
+			      WHEN $5 <> '' THEN user_id = $5
+			      WHEN $4 <> '' THEN team_name = $4 AND user_id = ''
+			      ELSE user_id = ''
+			  END
+		),
+		totals AS (
+			SELECT start, SUM(prs_opened) AS opened, SUM(prs_merged) AS merged,
+				SUM(reviews_assigned) AS assigned, SUM(reassignments) AS reassigned
+			FROM selected
+			GROUP BY start
+		),
+		medians AS (
+			SELECT start, percentile_cont(0.5) WITHIN GROUP (ORDER BY h) AS median
+			FROM selected, unnest(merge_hours) h
+			GROUP BY start
+		)
+		SELECT b.start, COALESCE(t.opened, 0), COALESCE(t.merged, 0),
+			COALESCE(t.assigned, 0), COALESCE(t.reassigned, 0), m.median
+		FROM buckets b
+		LEFT JOIN totals t ON t.start = b.start
+		LEFT JOIN medians m ON m.start = b.start
+		ORDER BY b.start
+	`, string(filter.Granularity), filter.From, filter.To, filter.TeamName, filter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("timeseries: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	points := []entity.TimeseriesPoint{}
+	for rows.Next() {
+		var p entity.TimeseriesPoint
+		var median sql.NullFloat64
+		if err := rows.Scan(&p.Start, &p.PRsOpened, &p.PRsMerged, &p.ReviewsAssigned, &p.Reassignments, &median); err != nil {
+			return nil, fmt.Errorf("timeseries: scan: %w", err)
+		}
+		if median.Valid {
+			p.MedianMergeHours = &median.Float64
+		}
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("timeseries: rows error: %w", err)
+	}
+	return points, nil
+}
+
+func (s *StatsStorage) Leaderboard(ctx context.Context, filter entity.LeaderboardFilter) ([]entity.LeaderboardEntry, error) {
+	q := s.getQuerier(ctx)
+
+	// Назначение берётся последним событием до merge: ревьювера могли снять и назначить снова
+	rows, err := q.QueryContext(ctx, `
+		WITH completed AS (
+			SELECT ra.reviewer_id AS user_id, COUNT(*) AS n,
+				AVG(EXTRACT(EPOCH FROM pr.merged_at - COALESCE(a.assigned_at, pr.created_at)) / 3600) AS turnaround_h
+			FROM review_assignments ra
+			JOIN pull_requests pr ON pr.id = ra.pr_id
+			LEFT JOIN LATERAL (
+				SELECT MAX(e.created_at) AS assigned_at
+				FROM pr_events e
+				WHERE e.pr_id = ra.pr_id AND e.reviewer_id = ra.reviewer_id
+				  AND e.type = 'REVIEWER_ASSIGNED' AND e.created_at <= pr.merged_at
+			) a ON true
+			WHERE pr.status = 'MERGED' AND pr.merged_at >= $2 AND pr.merged_at < $3
+			GROUP BY ra.reviewer_id
+		),
+		open AS (
+			SELECT ra.reviewer_id AS user_id, COUNT(*) AS n
+			FROM review_assignments ra
+			JOIN pull_requests pr ON pr.id = ra.pr_id
+			WHERE pr.status = 'OPEN'
+			GROUP BY ra.reviewer_id
+		),
+		history AS (
+			SELECT reviewer_id AS user_id,
+				COUNT(*) FILTER (WHERE type = 'REVIEWER_ASSIGNED') AS assigned,
+				COUNT(*) FILTER (WHERE type = 'REVIEWER_UNASSIGNED') AS unassigned
+			FROM pr_events
+			WHERE type IN ('REVIEWER_ASSIGNED', 'REVIEWER_UNASSIGNED')
+			  AND created_at >= $2 AND created_at < $3
+			GROUP BY reviewer_id
+		)
+		SELECT u.id, u.name, COALESCE(u.team_name, ''), u.is_active,
+			COALESCE(c.n, 0), COALESCE(o.n, 0), c.turnaround_h,
+			COALESCE(h.assigned, 0), COALESCE(h.unassigned, 0)
+		FROM users u
+		LEFT JOIN completed c ON c.user_id = u.id
+		LEFT JOIN open o ON o.user_id = u.id
+		LEFT JOIN history h ON h.user_id = u.id
+		WHERE $1 = '' OR u.team_name = $1
+		ORDER BY u.id
+	`, filter.TeamName, filter.From, filter.To)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboard: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	entries := []entity.LeaderboardEntry{}
+	for rows.Next() {
+		var e entity.LeaderboardEntry
+		var turnaround sql.NullFloat64
+		if err := rows.Scan(&e.UserID, &e.Username, &e.TeamName, &e.IsActive,
+			&e.ReviewsCompleted, &e.OpenReviews, &turnaround,
+			&e.AssignmentsReceived, &e.ReassignedAway); err != nil {
+			return nil, fmt.Errorf("leaderboard: scan: %w", err)
+		}
+		if turnaround.Valid {
+			e.AvgTurnaroundHours = &turnaround.Float64
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("leaderboard: rows error: %w", err)
+	}
+	return entries, nil
+}
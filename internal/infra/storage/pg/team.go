@@ -3,9 +3,11 @@ package pg
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/mark47B/be-internship/internal/domain/entity"
 	"github.com/mark47B/be-internship/internal/domain/repository"
 	"github.com/mark47B/be-internship/internal/domain/usecase"
@@ -30,14 +32,15 @@ func (s *TeamStorage) Get(ctx context.Context, name string) (entity.Team, error)
 	q := s.getQuerier(ctx)
 
 	// Проверяем существование команды
-	var exists bool
-	err := q.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, name).Scan(&exists)
+	var version int64
+	var parent sql.NullString
+	err := q.QueryRowContext(ctx, `SELECT version, parent_team FROM teams WHERE name = $1`, name).Scan(&version, &parent)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Team{}, usecase.ErrTeamNotFound
+		}
 		return entity.Team{}, fmt.Errorf("check team exists: %w", err)
 	}
-	if !exists {
-		return entity.Team{}, usecase.ErrTeamNotFound
-	}
 
 	rows, err := q.QueryContext(ctx, `
         SELECT id, name, is_active, team_name
@@ -48,11 +51,7 @@ func (s *TeamStorage) Get(ctx context.Context, name string) (entity.Team, error)
 	if err != nil {
 		return entity.Team{}, fmt.Errorf("query members: %w", err)
 	}
-	defer func() {
-		if closeErr := rows.Close(); closeErr != nil {
-			log.Printf("ERROR closing rows: %v", closeErr)
-		}
-	}()
+	defer CloseRows(ctx, rows)
 
 	var members []entity.User
 	for rows.Next() {
@@ -71,8 +70,10 @@ func (s *TeamStorage) Get(ctx context.Context, name string) (entity.Team, error)
 	}
 
 	return entity.Team{
-		Name:    name,
-		Members: members,
+		Name:       name,
+		Members:    members,
+		Version:    version,
+		ParentName: parent.String,
 	}, nil
 }
 
@@ -81,10 +82,323 @@ func (s *TeamStorage) Save(ctx context.Context, team entity.Team) error {
 
 	_, err := q.ExecContext(ctx, `
         INSERT INTO teams (name) VALUES ($1)
-        ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+        ON CONFLICT (tenant_id, name) DO UPDATE SET name = EXCLUDED.name
     `, team.Name)
 	if err != nil {
 		return fmt.Errorf("upsert team: %w", err)
 	}
 	return nil
 }
+
+func (s *TeamStorage) Update(ctx context.Context, team entity.Team) error {
+	q := s.getQuerier(ctx)
+
+	res, err := q.ExecContext(ctx, `
+        UPDATE teams SET version = version + 1
+        WHERE name = $1 AND version = $2
+    `, team.Name, team.Version)
+	if err != nil {
+		return fmt.Errorf("update team: %w", err)
+	}
+	return checkVersionUpdated(res)
+}
+
+func (s *TeamStorage) List(ctx context.Context) ([]entity.Team, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+        SELECT t.name, t.version, t.parent_team, u.id, u.name, u.is_active
+        FROM teams t
+        LEFT JOIN users u ON u.team_name = t.name
+        ORDER BY t.name, u.id
+    `)
+	if err != nil {
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	var teams []entity.Team
+	for rows.Next() {
+		var teamName string
+		var version int64
+		var parent, userID, username sql.NullString
+		var isActive sql.NullBool
+		if err := rows.Scan(&teamName, &version, &parent, &userID, &username, &isActive); err != nil {
+			return nil, fmt.Errorf("list teams: scan: %w", err)
+		}
+
+		if len(teams) == 0 || teams[len(teams)-1].Name != teamName {
+			teams = append(teams, entity.Team{Name: teamName, Members: []entity.User{}, Version: version, ParentName: parent.String})
+		}
+		// Команда без участников: LEFT JOIN вернул NULL
+		if !userID.Valid {
+			continue
+		}
+		team := &teams[len(teams)-1]
+		team.Members = append(team.Members, entity.User{
+			ID:       userID.String,
+			Username: username.String,
+			TeamName: teamName,
+			IsActive: isActive.Bool,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list teams: rows error: %w", err)
+	}
+
+	return teams, nil
+}
+
+// teamScope — условие «участник команды $1, а при $2 — и любой её дочерней» для колонки col
+func teamScope(col string) string {
+	return fmt.Sprintf(`(%[1]s = $1 OR ($2 AND %[1]s IN (SELECT team_subtree($1))))`, col)
+}
+
+func (s *TeamStorage) ReviewLoad(ctx context.Context, name string, subteams bool) ([]entity.MemberLoad, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT u.id, u.name, u.team_name, u.is_active, COUNT(pr.id)
+		FROM users u
+		LEFT JOIN review_assignments ra ON ra.reviewer_id = u.id
+		LEFT JOIN pull_requests pr ON pr.id = ra.pr_id AND pr.status = 'OPEN'
+		WHERE `+teamScope("u.team_name")+`
+		GROUP BY u.id, u.name, u.team_name, u.is_active
+		ORDER BY COUNT(pr.id) DESC, u.id
+	`, name, subteams)
+	if err != nil {
+		return nil, fmt.Errorf("team review load: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	load := []entity.MemberLoad{}
+	for rows.Next() {
+		var m entity.MemberLoad
+		if err := rows.Scan(&m.UserID, &m.Username, &m.TeamName, &m.IsActive, &m.OpenReviews); err != nil {
+			return nil, fmt.Errorf("team review load: scan: %w", err)
+		}
+		load = append(load, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("team review load: rows error: %w", err)
+	}
+	return load, nil
+}
+
+func (s *TeamStorage) Understaffed(ctx context.Context, name string, subteams bool, required int) ([]entity.PullRequest, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.version,
+			COALESCE(array_agg(ra.reviewer_id ORDER BY ra.reviewer_id) FILTER (WHERE ra.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		JOIN users u ON u.id = pr.author_id
+		LEFT JOIN review_assignments ra ON ra.pr_id = pr.id
+		WHERE `+teamScope("u.team_name")+` AND pr.status = 'OPEN'
+		GROUP BY pr.tenant_id, pr.id
+		HAVING COUNT(ra.reviewer_id) < $3
+		ORDER BY pr.created_at, pr.id
+	`, name, subteams, required)
+	if err != nil {
+		return nil, fmt.Errorf("understaffed PRs: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	prs := []entity.PullRequest{}
+	for rows.Next() {
+		var pr entity.PullRequest
+		var createdAt time.Time
+		var status string
+		var reviewers pq.StringArray
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &createdAt, &pr.Version, &reviewers); err != nil {
+			return nil, fmt.Errorf("understaffed PRs: scan: %w", err)
+		}
+		pr.Status = entity.PRStatus(status)
+		pr.CreatedAt = &createdAt
+		pr.Reviewers = reviewers
+		prs = append(prs, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("understaffed PRs: rows error: %w", err)
+	}
+	return prs, nil
+}
+
+func (s *TeamStorage) GetSLA(ctx context.Context, name string) (entity.SLA, bool, error) {
+	q := s.getQuerier(ctx)
+
+	var remind, reassign float64
+	err := q.QueryRowContext(ctx, `
+		SELECT EXTRACT(EPOCH FROM remind_after)::float8, EXTRACT(EPOCH FROM reassign_after)::float8
+		FROM team_sla WHERE team_name = $1
+	`, name).Scan(&remind, &reassign)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.SLA{}, false, nil
+	}
+	if err != nil {
+		return entity.SLA{}, false, fmt.Errorf("get team SLA: %w", err)
+	}
+	return entity.SLA{RemindAfter: fromSeconds(remind), ReassignAfter: fromSeconds(reassign)}, true, nil
+}
+
+func (s *TeamStorage) SaveSLA(ctx context.Context, name string, sla entity.SLA) error {
+	q := s.getQuerier(ctx)
+
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO team_sla (team_name, remind_after, reassign_after)
+		VALUES ($1, make_interval(secs => $2), make_interval(secs => $3))
+		ON CONFLICT (tenant_id, team_name) DO UPDATE
+		SET remind_after = EXCLUDED.remind_after, reassign_after = EXCLUDED.reassign_after
+	`, name, sla.RemindAfter.Seconds(), sla.ReassignAfter.Seconds())
+	if err != nil {
+		return fmt.Errorf("save team SLA: %w", err)
+	}
+	return nil
+}
+
+func (s *TeamStorage) GetEscalation(ctx context.Context, name string) (entity.EscalationPolicy, error) {
+	q := s.getQuerier(ctx)
+
+	policy := entity.EscalationPolicy{TeamName: name}
+	err := q.QueryRowContext(ctx, `
+		SELECT COALESCE(lead_user_id, ''), COALESCE(escalation_team, '')
+		FROM team_escalation WHERE team_name = $1
+	`, name).Scan(&policy.LeadUserID, &policy.EscalationTeam)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return entity.EscalationPolicy{}, fmt.Errorf("get team escalation: %w", err)
+	}
+	return policy, nil
+}
+
+func (s *TeamStorage) SaveEscalation(ctx context.Context, policy entity.EscalationPolicy) error {
+	q := s.getQuerier(ctx)
+
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO team_escalation (team_name, lead_user_id, escalation_team)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+		ON CONFLICT (tenant_id, team_name) DO UPDATE
+		SET lead_user_id = EXCLUDED.lead_user_id, escalation_team = EXCLUDED.escalation_team
+	`, policy.TeamName, policy.LeadUserID, policy.EscalationTeam)
+	if err != nil {
+		return fmt.Errorf("save team escalation: %w", err)
+	}
+	return nil
+}
+
+func (s *TeamStorage) SetParent(ctx context.Context, name, parent string) error {
+	q := s.getQuerier(ctx)
+
+	// Цикл ловит триггер fn_team_hierarchy_checks: он же сериализует параллельные переносы
+	res, err := q.ExecContext(ctx, `UPDATE teams SET parent_team = NULLIF($2, '') WHERE name = $1`, name, parent)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && (pqErr.Constraint == "teams_parent_no_cycle" || pqErr.Constraint == "teams_parent_not_self") {
+		return usecase.ErrTeamCycle
+	}
+	if err != nil {
+		return fmt.Errorf("set team parent: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return usecase.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (s *TeamStorage) Ancestors(ctx context.Context, name string) ([]string, error) {
+	q := s.getQuerier(ctx)
+
+	// depth ограничивает обход, даже если цикл как-то попал в данные в обход триггера
+	rows, err := q.QueryContext(ctx, `
+		WITH RECURSIVE up(name, depth) AS (
+			SELECT parent_team, 1 FROM teams WHERE name = $1 AND parent_team IS NOT NULL
+			UNION ALL
+			SELECT t.parent_team, up.depth + 1
+			FROM teams t JOIN up ON t.name = up.name
+			WHERE t.parent_team IS NOT NULL AND up.depth < 100
+		)
+		SELECT name FROM up ORDER BY depth
+	`, name)
+	if err != nil {
+		return nil, fmt.Errorf("team ancestors: query: %w", err)
+	}
+	return scanNames(ctx, rows, "team ancestors")
+}
+
+func (s *TeamStorage) Descendants(ctx context.Context, name string) ([]string, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT s FROM team_subtree($1) s WHERE s <> $1 ORDER BY s
+	`, name)
+	if err != nil {
+		return nil, fmt.Errorf("team descendants: query: %w", err)
+	}
+	return scanNames(ctx, rows, "team descendants")
+}
+
+func (s *TeamStorage) Hierarchy(ctx context.Context, name string) ([]entity.TeamNode, error) {
+	q := s.getQuerier(ctx)
+
+	// Показатели считаются по всем командам выборки одним запросом, свёртка по дереву — в сервисе
+	rows, err := q.QueryContext(ctx, `
+		WITH RECURSIVE tree(name, parent_team, depth) AS (
+			SELECT name, parent_team, 0 FROM teams
+			WHERE ($1 <> '' AND name = $1) OR ($1 = '' AND parent_team IS NULL)
+			UNION
+			SELECT t.name, t.parent_team, tree.depth + 1
+			FROM teams t JOIN tree ON t.parent_team = tree.name
+		)
+		SELECT tree.name, COALESCE(tree.parent_team, ''),
+			(SELECT COUNT(*) FROM users u WHERE u.team_name = tree.name),
+			(SELECT COUNT(*) FROM users u WHERE u.team_name = tree.name AND u.is_active),
+			(SELECT COUNT(*) FROM pull_requests pr JOIN users u ON u.id = pr.author_id
+				WHERE u.team_name = tree.name AND pr.status = 'OPEN'),
+			(SELECT COUNT(*) FROM pull_requests pr JOIN users u ON u.id = pr.author_id
+				WHERE u.team_name = tree.name AND pr.status = 'MERGED'),
+			(SELECT COUNT(*) FROM review_assignments ra
+				JOIN users u ON u.id = ra.reviewer_id
+				JOIN pull_requests pr ON pr.id = ra.pr_id
+				WHERE u.team_name = tree.name AND pr.status = 'OPEN')
+		FROM tree
+		ORDER BY tree.depth, tree.name
+	`, name)
+	if err != nil {
+		return nil, fmt.Errorf("team hierarchy: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	nodes := []entity.TeamNode{}
+	for rows.Next() {
+		var n entity.TeamNode
+		if err := rows.Scan(&n.Name, &n.ParentName, &n.Own.Members, &n.Own.ActiveMembers,
+			&n.Own.OpenPRs, &n.Own.MergedPRs, &n.Own.OpenReviews); err != nil {
+			return nil, fmt.Errorf("team hierarchy: scan: %w", err)
+		}
+		nodes = append(nodes, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("team hierarchy: rows error: %w", err)
+	}
+	return nodes, nil
+}
+
+func scanNames(ctx context.Context, rows *sql.Rows, op string) ([]string, error) {
+	defer CloseRows(ctx, rows)
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("%s: scan: %w", op, err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+	return names, nil
+}
+
+// fromSeconds — обратное к time.Duration.Seconds для значений EXTRACT(EPOCH FROM interval)
+func fromSeconds(sec float64) time.Duration {
+	return time.Duration(sec * float64(time.Second))
+}
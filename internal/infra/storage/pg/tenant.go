@@ -0,0 +1,88 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+// TenantStorage — таблица tenants без row-level security: по ней проверяют арендатора до того,
+// как он выставлен в сессии
+type TenantStorage struct {
+	db *sql.DB
+}
+
+func NewTenantStorage(db *sql.DB) repository.TenantRepository {
+	return &TenantStorage{db: db}
+}
+
+func (s *TenantStorage) getQuerier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok && tx != nil {
+		return tx
+	}
+	return s.db
+}
+
+func (s *TenantStorage) Create(ctx context.Context, t entity.Tenant) (entity.Tenant, error) {
+	q := s.getQuerier(ctx)
+
+	err := q.QueryRowContext(ctx, `
+		INSERT INTO tenants (id, name)
+		VALUES ($1, $2)
+		ON CONFLICT (id) DO NOTHING
+		RETURNING created_at
+	`, t.ID, t.Name).Scan(&t.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Tenant{}, usecase.ErrTenantExists
+		}
+		return entity.Tenant{}, fmt.Errorf("create tenant: %w", err)
+	}
+
+	return t, nil
+}
+
+func (s *TenantStorage) Get(ctx context.Context, id string) (entity.Tenant, error) {
+	q := s.getQuerier(ctx)
+
+	var t entity.Tenant
+	err := q.QueryRowContext(ctx, `SELECT id, name, created_at FROM tenants WHERE id = $1`, id).
+		Scan(&t.ID, &t.Name, &t.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Tenant{}, usecase.ErrTenantNotFound
+		}
+		return entity.Tenant{}, fmt.Errorf("get tenant: %w", err)
+	}
+
+	return t, nil
+}
+
+func (s *TenantStorage) List(ctx context.Context) ([]entity.Tenant, error) {
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `SELECT id, name, created_at FROM tenants ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("list tenants: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	var tenants []entity.Tenant
+	for rows.Next() {
+		var t entity.Tenant
+		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan tenant: %w", err)
+		}
+		tenants = append(tenants, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return tenants, nil
+}
@@ -5,11 +5,21 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+
+	"github.com/lib/pq"
 
 	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
 )
 
+// Коды Postgres, при которых транзакция проиграла гонку и её можно повторить
+var conflictCodes = map[pq.ErrorCode]bool{
+	"23505": true, // unique_violation
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+}
+
 type TxManager struct {
 	db *sql.DB
 }
@@ -26,14 +36,27 @@ func (m *TxManager) Do(ctx context.Context, fn func(context.Context) error) erro
 }
 
 func (m *TxManager) DoTx(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
-	tx, err := m.db.BeginTx(ctx, nil)
+	return m.run(ctx, nil, fn)
+}
+
+// DoSnapshot — REPEATABLE READ READ ONLY: снимок берётся первым запросом и держится до конца fn
+func (m *TxManager) DoSnapshot(ctx context.Context, fn func(context.Context) error) error {
+	_, err := m.run(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
+		func(ctx context.Context) (any, error) {
+			return nil, fn(ctx)
+		})
+	return err
+}
+
+func (m *TxManager) run(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) (any, error)) (any, error) {
+	tx, err := m.db.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(); err != nil {
 			if !errors.Is(err, sql.ErrTxDone) {
-				log.Printf("WARNING: transaction Rollback failed: %v", err)
+				slog.WarnContext(ctx, "transaction rollback failed", "error", err)
 			}
 		}
 	}()
@@ -41,16 +64,25 @@ func (m *TxManager) DoTx(ctx context.Context, fn func(context.Context) (any, err
 
 	result, err := fn(ctx)
 	if err != nil {
-		return nil, err
+		return nil, conflictError(err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("commit tx: %w", err)
+		return nil, conflictError(fmt.Errorf("commit tx: %w", err))
 	}
 
 	return result, nil
 }
 
+// conflictError помечает ошибку как usecase.ErrConflict, сохраняя исходную для логов
+func conflictError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && conflictCodes[pqErr.Code] {
+		return fmt.Errorf("%w: %w", usecase.ErrConflict, err)
+	}
+	return err
+}
+
 // txKey — приватный ключ для хранения *sql.Tx в контексте
 type txKey struct{}
 
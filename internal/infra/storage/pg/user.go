@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/mark47B/be-internship/internal/domain/entity"
@@ -33,10 +34,10 @@ func (s *UserStorage) Get(ctx context.Context, id string) (entity.User, error) {
 	var teamName sql.NullString
 
 	err := q.QueryRowContext(ctx, `
-		SELECT id, name, team_name, is_active
+		SELECT id, name, team_name, is_active, version
 		FROM users
 		WHERE id = $1
-	`, id).Scan(&u.ID, &u.Username, &teamName, &u.IsActive)
+	`, id).Scan(&u.ID, &u.Username, &teamName, &u.IsActive, &u.Version)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return entity.User{}, usecase.ErrUserNotFound
@@ -63,7 +64,7 @@ func (s *UserStorage) GetByTeam(ctx context.Context, teamName string) ([]entity.
 	if err != nil {
 		return nil, fmt.Errorf("get users by team: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
 	var users []entity.User
 	for rows.Next() {
@@ -88,6 +89,43 @@ func (s *UserStorage) GetByTeam(ctx context.Context, teamName string) ([]entity.
 	return users, nil
 }
 
+func (s *UserStorage) GetMany(ctx context.Context, ids []string) ([]entity.User, error) {
+	if len(ids) == 0 {
+		return []entity.User{}, nil
+	}
+
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT id, name, team_name, is_active
+		FROM users
+		WHERE id = ANY($1::text[])
+		ORDER BY id
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	var users []entity.User
+	for rows.Next() {
+		var u entity.User
+		var teamName sql.NullString
+
+		if err := rows.Scan(&u.ID, &u.Username, &teamName, &u.IsActive); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		u.TeamName = teamName.String
+
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return users, nil
+}
+
 func (s *UserStorage) GetActiveByTeam(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
 	q := s.getQuerier(ctx)
 
@@ -100,7 +138,7 @@ func (s *UserStorage) GetActiveByTeam(ctx context.Context, teamName string, excl
 	if err != nil {
 		return nil, fmt.Errorf("get active users by team: %w", err)
 	}
-	defer CloseRows(rows)
+	defer CloseRows(ctx, rows)
 
 	var users []entity.User
 	for rows.Next() {
@@ -142,7 +180,7 @@ func (s *UserStorage) UpdateMany(ctx context.Context, users []entity.User) error
 
 	query := `
 		UPDATE users
-		SET is_active = data.is_active
+		SET is_active = data.is_active, version = users.version + 1
 		FROM (
 			SELECT unnest($1::text[]) as id, unnest($2::boolean[]) as is_active
 		) as data
@@ -156,6 +194,19 @@ func (s *UserStorage) UpdateMany(ctx context.Context, users []entity.User) error
 	return nil
 }
 
+func (s *UserStorage) Update(ctx context.Context, user entity.User) error {
+	q := s.getQuerier(ctx)
+
+	res, err := q.ExecContext(ctx, `
+		UPDATE users SET is_active = $3, version = version + 1
+		WHERE id = $1 AND version = $2
+	`, user.ID, user.Version, user.IsActive)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	return checkVersionUpdated(res)
+}
+
 func (s *UserStorage) GetUserStats(ctx context.Context, userID string) (entity.UserStats, error) {
 	q := s.getQuerier(ctx)
 
@@ -205,10 +256,11 @@ func (s *UserStorage) SaveUpdateMany(ctx context.Context, users []entity.User) e
             unnest($2::text[]),
             unnest($3::text[]),
             unnest($4::boolean[])
-        ON CONFLICT (id) DO UPDATE SET
+        ON CONFLICT (tenant_id, id) DO UPDATE SET
             name      = EXCLUDED.name,
             team_name = EXCLUDED.team_name,
-            is_active = EXCLUDED.is_active
+            is_active = EXCLUDED.is_active,
+            version   = users.version + 1
     `
 
 	_, err := q.ExecContext(ctx, query,
@@ -232,7 +284,7 @@ func (s *UserStorage) DeactivateMany(ctx context.Context, userIDs []string) erro
 
 	query := `
         UPDATE users
-        SET is_active = false
+        SET is_active = false, version = version + 1
         WHERE id = ANY($1)
           AND is_active = true
     `
@@ -244,3 +296,87 @@ func (s *UserStorage) DeactivateMany(ctx context.Context, userIDs []string) erro
 
 	return nil
 }
+
+func (s *UserStorage) GetNotificationPrefs(ctx context.Context, userIDs []string) ([]entity.NotificationPrefs, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	q := s.getQuerier(ctx)
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT user_id, channels, COALESCE(email, ''), quiet_start, quiet_end, time_zone
+		FROM user_notification_prefs
+		WHERE user_id = ANY($1)
+		ORDER BY user_id
+	`, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("get notification prefs: query: %w", err)
+	}
+	defer CloseRows(ctx, rows)
+
+	var prefs []entity.NotificationPrefs
+	for rows.Next() {
+		var p entity.NotificationPrefs
+		var channels pq.StringArray
+		var quietStart, quietEnd sql.NullInt64
+		var timeZone string
+		if err := rows.Scan(&p.UserID, &channels, &p.Email, &quietStart, &quietEnd, &timeZone); err != nil {
+			return nil, fmt.Errorf("get notification prefs: scan: %w", err)
+		}
+		p.Channels = make([]entity.NotificationChannel, len(channels))
+		for i, c := range channels {
+			p.Channels[i] = entity.NotificationChannel(c)
+		}
+		if quietStart.Valid && quietEnd.Valid {
+			loc, err := time.LoadLocation(timeZone)
+			if err != nil {
+				return nil, fmt.Errorf("get notification prefs: user %s: %w", p.UserID, err)
+			}
+			p.QuietHours = &entity.QuietHours{
+				Start:    time.Duration(quietStart.Int64) * time.Minute,
+				End:      time.Duration(quietEnd.Int64) * time.Minute,
+				Location: loc,
+			}
+		}
+		prefs = append(prefs, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get notification prefs: rows error: %w", err)
+	}
+	return prefs, nil
+}
+
+func (s *UserStorage) SaveNotificationPrefs(ctx context.Context, prefs entity.NotificationPrefs) error {
+	q := s.getQuerier(ctx)
+
+	channels := make([]string, len(prefs.Channels))
+	for i, c := range prefs.Channels {
+		channels[i] = string(c)
+	}
+	var email sql.NullString
+	if prefs.Email != "" {
+		email = sql.NullString{String: prefs.Email, Valid: true}
+	}
+	var quietStart, quietEnd sql.NullInt64
+	timeZone := "UTC"
+	if qh := prefs.QuietHours; qh != nil {
+		quietStart = sql.NullInt64{Int64: int64(qh.Start / time.Minute), Valid: true}
+		quietEnd = sql.NullInt64{Int64: int64(qh.End / time.Minute), Valid: true}
+		timeZone = qh.Location.String()
+	}
+
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO user_notification_prefs (user_id, channels, email, quiet_start, quiet_end, time_zone)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, user_id) DO UPDATE SET
+			channels = EXCLUDED.channels,
+			email = EXCLUDED.email,
+			quiet_start = EXCLUDED.quiet_start,
+			quiet_end = EXCLUDED.quiet_end,
+			time_zone = EXCLUDED.time_zone
+	`, prefs.UserID, pq.Array(channels), email, quietStart, quietEnd, timeZone)
+	if err != nil {
+		return fmt.Errorf("save notification prefs: %w", err)
+	}
+	return nil
+}
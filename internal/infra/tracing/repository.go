@@ -0,0 +1,440 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+)
+
+// Декораторы репозиториев: спан "<Repository>.<Method>" на каждый вызов хранилища,
+// чтобы в трассировке медленного запроса был виден конкретный SQL-вызов.
+
+func InstrumentTeamRepository(next repository.TeamRepository) repository.TeamRepository {
+	return &teamRepo{next: next}
+}
+
+type teamRepo struct {
+	next repository.TeamRepository
+}
+
+func (r *teamRepo) Save(ctx context.Context, team entity.Team) error {
+	ctx, span := startDB(ctx, "TeamRepository.Save")
+	return end(span, r.next.Save(ctx, team))
+}
+
+func (r *teamRepo) Get(ctx context.Context, name string) (entity.Team, error) {
+	ctx, span := startDB(ctx, "TeamRepository.Get")
+	res, err := r.next.Get(ctx, name)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) Update(ctx context.Context, team entity.Team) error {
+	ctx, span := startDB(ctx, "TeamRepository.Update")
+	return end(span, r.next.Update(ctx, team))
+}
+
+func (r *teamRepo) List(ctx context.Context) ([]entity.Team, error) {
+	ctx, span := startDB(ctx, "TeamRepository.List")
+	res, err := r.next.List(ctx)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) ReviewLoad(ctx context.Context, name string, subteams bool) ([]entity.MemberLoad, error) {
+	ctx, span := startDB(ctx, "TeamRepository.ReviewLoad")
+	res, err := r.next.ReviewLoad(ctx, name, subteams)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) Understaffed(ctx context.Context, name string, subteams bool, required int) ([]entity.PullRequest, error) {
+	ctx, span := startDB(ctx, "TeamRepository.Understaffed")
+	res, err := r.next.Understaffed(ctx, name, subteams, required)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) GetSLA(ctx context.Context, name string) (entity.SLA, bool, error) {
+	ctx, span := startDB(ctx, "TeamRepository.GetSLA")
+	res, ok, err := r.next.GetSLA(ctx, name)
+	return res, ok, end(span, err)
+}
+
+func (r *teamRepo) SaveSLA(ctx context.Context, name string, sla entity.SLA) error {
+	ctx, span := startDB(ctx, "TeamRepository.SaveSLA")
+	return end(span, r.next.SaveSLA(ctx, name, sla))
+}
+
+func (r *teamRepo) GetEscalation(ctx context.Context, name string) (entity.EscalationPolicy, error) {
+	ctx, span := startDB(ctx, "TeamRepository.GetEscalation")
+	res, err := r.next.GetEscalation(ctx, name)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) SaveEscalation(ctx context.Context, policy entity.EscalationPolicy) error {
+	ctx, span := startDB(ctx, "TeamRepository.SaveEscalation")
+	return end(span, r.next.SaveEscalation(ctx, policy))
+}
+
+func (r *teamRepo) SetParent(ctx context.Context, name, parent string) error {
+	ctx, span := startDB(ctx, "TeamRepository.SetParent")
+	return end(span, r.next.SetParent(ctx, name, parent))
+}
+
+func (r *teamRepo) Ancestors(ctx context.Context, name string) ([]string, error) {
+	ctx, span := startDB(ctx, "TeamRepository.Ancestors")
+	res, err := r.next.Ancestors(ctx, name)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) Descendants(ctx context.Context, name string) ([]string, error) {
+	ctx, span := startDB(ctx, "TeamRepository.Descendants")
+	res, err := r.next.Descendants(ctx, name)
+	return res, end(span, err)
+}
+
+func (r *teamRepo) Hierarchy(ctx context.Context, name string) ([]entity.TeamNode, error) {
+	ctx, span := startDB(ctx, "TeamRepository.Hierarchy")
+	res, err := r.next.Hierarchy(ctx, name)
+	return res, end(span, err)
+}
+
+func InstrumentUserRepository(next repository.UserRepository) repository.UserRepository {
+	return &userRepo{next: next}
+}
+
+type userRepo struct {
+	next repository.UserRepository
+}
+
+func (r *userRepo) SaveUpdateMany(ctx context.Context, user []entity.User) error {
+	ctx, span := startDB(ctx, "UserRepository.SaveUpdateMany")
+	return end(span, r.next.SaveUpdateMany(ctx, user))
+}
+
+func (r *userRepo) Get(ctx context.Context, id string) (entity.User, error) {
+	ctx, span := startDB(ctx, "UserRepository.Get")
+	res, err := r.next.Get(ctx, id)
+	return res, end(span, err)
+}
+
+func (r *userRepo) GetByTeam(ctx context.Context, teamName string) ([]entity.User, error) {
+	ctx, span := startDB(ctx, "UserRepository.GetByTeam")
+	res, err := r.next.GetByTeam(ctx, teamName)
+	return res, end(span, err)
+}
+
+func (r *userRepo) GetMany(ctx context.Context, ids []string) ([]entity.User, error) {
+	ctx, span := startDB(ctx, "UserRepository.GetMany")
+	res, err := r.next.GetMany(ctx, ids)
+	return res, end(span, err)
+}
+
+func (r *userRepo) GetActiveByTeam(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	ctx, span := startDB(ctx, "UserRepository.GetActiveByTeam")
+	res, err := r.next.GetActiveByTeam(ctx, teamName, excludeUserID)
+	return res, end(span, err)
+}
+
+func (r *userRepo) UpdateMany(ctx context.Context, users []entity.User) error {
+	ctx, span := startDB(ctx, "UserRepository.UpdateMany")
+	return end(span, r.next.UpdateMany(ctx, users))
+}
+
+func (r *userRepo) Update(ctx context.Context, user entity.User) error {
+	ctx, span := startDB(ctx, "UserRepository.Update")
+	return end(span, r.next.Update(ctx, user))
+}
+
+func (r *userRepo) GetUserStats(ctx context.Context, userID string) (entity.UserStats, error) {
+	ctx, span := startDB(ctx, "UserRepository.GetUserStats")
+	res, err := r.next.GetUserStats(ctx, userID)
+	return res, end(span, err)
+}
+
+func (r *userRepo) DeactivateMany(ctx context.Context, userIDs []string) error {
+	ctx, span := startDB(ctx, "UserRepository.DeactivateMany")
+	return end(span, r.next.DeactivateMany(ctx, userIDs))
+}
+
+func (r *userRepo) GetNotificationPrefs(ctx context.Context, userIDs []string) ([]entity.NotificationPrefs, error) {
+	ctx, span := startDB(ctx, "UserRepository.GetNotificationPrefs")
+	res, err := r.next.GetNotificationPrefs(ctx, userIDs)
+	return res, end(span, err)
+}
+
+func (r *userRepo) SaveNotificationPrefs(ctx context.Context, prefs entity.NotificationPrefs) error {
+	ctx, span := startDB(ctx, "UserRepository.SaveNotificationPrefs")
+	return end(span, r.next.SaveNotificationPrefs(ctx, prefs))
+}
+
+func InstrumentPullRequestRepository(next repository.PullRequestRepository) repository.PullRequestRepository {
+	return &pullRequestRepo{next: next}
+}
+
+type pullRequestRepo struct {
+	next repository.PullRequestRepository
+}
+
+func (r *pullRequestRepo) Save(ctx context.Context, pr entity.PullRequest) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.Save")
+	return end(span, r.next.Save(ctx, pr))
+}
+
+func (r *pullRequestRepo) Get(ctx context.Context, id string) (entity.PullRequest, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.Get")
+	res, err := r.next.Get(ctx, id)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) GetByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequest, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.GetByReviewer")
+	res, err := r.next.GetByReviewer(ctx, reviewerID)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) Update(ctx context.Context, pr entity.PullRequest) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.Update")
+	return end(span, r.next.Update(ctx, pr))
+}
+
+func (r *pullRequestRepo) GetReviewers(ctx context.Context, prID string) ([]string, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.GetReviewers")
+	res, err := r.next.GetReviewers(ctx, prID)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) AssignReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.AssignReviewers")
+	return end(span, r.next.AssignReviewers(ctx, prID, reviewerIDs))
+}
+
+func (r *pullRequestRepo) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.ReplaceReviewer")
+	return end(span, r.next.ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID))
+}
+
+func (r *pullRequestRepo) RemoveReviewer(ctx context.Context, prID, reviewerID string) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.RemoveReviewer")
+	return end(span, r.next.RemoveReviewer(ctx, prID, reviewerID))
+}
+
+func (r *pullRequestRepo) ApplyReviewerChanges(ctx context.Context, changes []entity.ReviewerChange) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.ApplyReviewerChanges")
+	return end(span, r.next.ApplyReviewerChanges(ctx, changes))
+}
+
+func (r *pullRequestRepo) BumpVersions(ctx context.Context, prs []entity.PullRequest) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.BumpVersions")
+	return end(span, r.next.BumpVersions(ctx, prs))
+}
+
+func (r *pullRequestRepo) GetStats(ctx context.Context, filter entity.StatsFilter) (entity.PRStats, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.GetStats")
+	res, err := r.next.GetStats(ctx, filter)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) ([]entity.PullRequest, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.GetOpenPRsByReviewers")
+	res, err := r.next.GetOpenPRsByReviewers(ctx, reviewerIDs)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) GetReviewersBatch(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.GetReviewersBatch")
+	res, err := r.next.GetReviewersBatch(ctx, prIDs)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.CountOpenReviews")
+	res, err := r.next.CountOpenReviews(ctx, userIDs)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) List(ctx context.Context, afterID string, limit int) ([]entity.PullRequest, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.List")
+	res, err := r.next.List(ctx, afterID, limit)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) ListStale(ctx context.Context, now time.Time, def entity.SLA) ([]entity.StalePR, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.ListStale")
+	res, err := r.next.ListStale(ctx, now, def)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) ListOverdueReviews(ctx context.Context, now time.Time, def entity.SLA) ([]entity.OverdueReview, error) {
+	ctx, span := startDB(ctx, "PullRequestRepository.ListOverdueReviews")
+	res, err := r.next.ListOverdueReviews(ctx, now, def)
+	return res, end(span, err)
+}
+
+func (r *pullRequestRepo) MarkReminded(ctx context.Context, prIDs []string, at time.Time) error {
+	ctx, span := startDB(ctx, "PullRequestRepository.MarkReminded")
+	return end(span, r.next.MarkReminded(ctx, prIDs, at))
+}
+
+func InstrumentEventRepository(next repository.EventRepository) repository.EventRepository {
+	return &eventRepo{next: next}
+}
+
+type eventRepo struct {
+	next repository.EventRepository
+}
+
+func (r *eventRepo) Append(ctx context.Context, events []entity.Event) ([]entity.Event, error) {
+	ctx, span := startDB(ctx, "EventRepository.Append")
+	res, err := r.next.Append(ctx, events)
+	return res, end(span, err)
+}
+
+func (r *eventRepo) ListAfter(ctx context.Context, afterID int64, filter entity.EventFilter, limit int) ([]entity.Event, error) {
+	ctx, span := startDB(ctx, "EventRepository.ListAfter")
+	res, err := r.next.ListAfter(ctx, afterID, filter, limit)
+	return res, end(span, err)
+}
+
+func (r *eventRepo) Cursor(ctx context.Context, name string) (int64, error) {
+	ctx, span := startDB(ctx, "EventRepository.Cursor")
+	res, err := r.next.Cursor(ctx, name)
+	return res, end(span, err)
+}
+
+func (r *eventRepo) SaveCursor(ctx context.Context, name string, lastID int64) error {
+	ctx, span := startDB(ctx, "EventRepository.SaveCursor")
+	return end(span, r.next.SaveCursor(ctx, name, lastID))
+}
+
+func InstrumentAPIKeyRepository(next repository.APIKeyRepository) repository.APIKeyRepository {
+	return &apiKeyRepo{next: next}
+}
+
+type apiKeyRepo struct {
+	next repository.APIKeyRepository
+}
+
+func (r *apiKeyRepo) Save(ctx context.Context, key entity.APIKey) (entity.APIKey, error) {
+	ctx, span := startDB(ctx, "APIKeyRepository.Save")
+	res, err := r.next.Save(ctx, key)
+	return res, end(span, err)
+}
+
+func (r *apiKeyRepo) GetByHash(ctx context.Context, hash string) (entity.APIKey, error) {
+	ctx, span := startDB(ctx, "APIKeyRepository.GetByHash")
+	res, err := r.next.GetByHash(ctx, hash)
+	return res, end(span, err)
+}
+
+func InstrumentTenantRepository(next repository.TenantRepository) repository.TenantRepository {
+	return &tenantRepo{next: next}
+}
+
+type tenantRepo struct {
+	next repository.TenantRepository
+}
+
+func (r *tenantRepo) Create(ctx context.Context, t entity.Tenant) (entity.Tenant, error) {
+	ctx, span := startDB(ctx, "TenantRepository.Create")
+	res, err := r.next.Create(ctx, t)
+	return res, end(span, err)
+}
+
+func (r *tenantRepo) Get(ctx context.Context, id string) (entity.Tenant, error) {
+	ctx, span := startDB(ctx, "TenantRepository.Get")
+	res, err := r.next.Get(ctx, id)
+	return res, end(span, err)
+}
+
+func (r *tenantRepo) List(ctx context.Context) ([]entity.Tenant, error) {
+	ctx, span := startDB(ctx, "TenantRepository.List")
+	res, err := r.next.List(ctx)
+	return res, end(span, err)
+}
+
+func InstrumentIdempotencyRepository(next repository.IdempotencyRepository) repository.IdempotencyRepository {
+	return &idempotencyRepo{next: next}
+}
+
+type idempotencyRepo struct {
+	next repository.IdempotencyRepository
+}
+
+func (r *idempotencyRepo) Claim(ctx context.Context, rec entity.IdempotencyRecord, ttl, lease time.Duration) (entity.IdempotencyRecord, bool, error) {
+	ctx, span := startDB(ctx, "IdempotencyRepository.Claim")
+	res, ok, err := r.next.Claim(ctx, rec, ttl, lease)
+	return res, ok, end(span, err)
+}
+
+func (r *idempotencyRepo) Complete(ctx context.Context, rec entity.IdempotencyRecord) error {
+	ctx, span := startDB(ctx, "IdempotencyRepository.Complete")
+	return end(span, r.next.Complete(ctx, rec))
+}
+
+func (r *idempotencyRepo) Release(ctx context.Context, scope, key string) error {
+	ctx, span := startDB(ctx, "IdempotencyRepository.Release")
+	return end(span, r.next.Release(ctx, scope, key))
+}
+
+func (r *idempotencyRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, span := startDB(ctx, "IdempotencyRepository.DeleteExpired")
+	res, err := r.next.DeleteExpired(ctx)
+	return res, end(span, err)
+}
+
+// InstrumentTxManager — спан на всю транзакцию, вызовы репозиториев внутри неё становятся дочерними
+func InstrumentTxManager(next repository.TxManager) repository.TxManager {
+	return &txManager{next: next}
+}
+
+type txManager struct {
+	next repository.TxManager
+}
+
+func (t *txManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := startDB(ctx, "TxManager.Do")
+	return end(span, t.next.Do(ctx, fn))
+}
+
+func (t *txManager) DoTx(ctx context.Context, fn func(ctx context.Context) (any, error)) (any, error) {
+	ctx, span := startDB(ctx, "TxManager.DoTx")
+	res, err := t.next.DoTx(ctx, fn)
+	return res, end(span, err)
+}
+
+func (t *txManager) DoSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := startDB(ctx, "TxManager.DoSnapshot")
+	return end(span, t.next.DoSnapshot(ctx, fn))
+}
+
+func InstrumentStatsRepository(next repository.StatsRepository) repository.StatsRepository {
+	return &statsRepo{next: next}
+}
+
+type statsRepo struct {
+	next repository.StatsRepository
+}
+
+func (r *statsRepo) Rollup(ctx context.Context, from, to time.Time) (int, error) {
+	ctx, span := startDB(ctx, "StatsRepository.Rollup")
+	res, err := r.next.Rollup(ctx, from, to)
+	return res, end(span, err)
+}
+
+func (r *statsRepo) RollupStart(ctx context.Context) (time.Time, bool, error) {
+	ctx, span := startDB(ctx, "StatsRepository.RollupStart")
+	day, ok, err := r.next.RollupStart(ctx)
+	return day, ok, end(span, err)
+}
+
+func (r *statsRepo) Timeseries(ctx context.Context, filter entity.TimeseriesFilter) ([]entity.TimeseriesPoint, error) {
+	ctx, span := startDB(ctx, "StatsRepository.Timeseries")
+	res, err := r.next.Timeseries(ctx, filter)
+	return res, end(span, err)
+}
+
+func (r *statsRepo) Leaderboard(ctx context.Context, filter entity.LeaderboardFilter) ([]entity.LeaderboardEntry, error) {
+	ctx, span := startDB(ctx, "StatsRepository.Leaderboard")
+	res, err := r.next.Leaderboard(ctx, filter)
+	return res, end(span, err)
+}
@@ -0,0 +1,183 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+)
+
+// InstrumentService оборачивает каждый метод сервиса в спан "Service.<Method>"
+func InstrumentService(next usecase.Service) usecase.Service {
+	return &service{next: next}
+}
+
+type service struct {
+	next usecase.Service
+}
+
+func (s *service) AddOrUpdateTeam(ctx context.Context, team entity.Team) (entity.Team, error) {
+	ctx, span := start(ctx, "Service.AddOrUpdateTeam")
+	res, err := s.next.AddOrUpdateTeam(ctx, team)
+	return res, end(span, err)
+}
+
+func (s *service) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
+	ctx, span := start(ctx, "Service.GetTeam")
+	res, err := s.next.GetTeam(ctx, teamName)
+	return res, end(span, err)
+}
+
+func (s *service) DeactivateUsersAndReassign(ctx context.Context, teamName string, userIDs []string, ifVersion int64) error {
+	ctx, span := start(ctx, "Service.DeactivateUsersAndReassign")
+	return end(span, s.next.DeactivateUsersAndReassign(ctx, teamName, userIDs, ifVersion))
+}
+
+func (s *service) GetTeamStats(ctx context.Context, teamName string, subteams bool) (entity.TeamStats, error) {
+	ctx, span := start(ctx, "Service.GetTeamStats")
+	res, err := s.next.GetTeamStats(ctx, teamName, subteams)
+	return res, end(span, err)
+}
+
+func (s *service) SetTeamParent(ctx context.Context, teamName, parent string) (entity.Team, error) {
+	ctx, span := start(ctx, "Service.SetTeamParent")
+	res, err := s.next.SetTeamParent(ctx, teamName, parent)
+	return res, end(span, err)
+}
+
+func (s *service) GetTeamTree(ctx context.Context, teamName string) ([]entity.TeamNode, error) {
+	ctx, span := start(ctx, "Service.GetTeamTree")
+	res, err := s.next.GetTeamTree(ctx, teamName)
+	return res, end(span, err)
+}
+
+func (s *service) GetTeamSLA(ctx context.Context, teamName string) (entity.TeamSLA, error) {
+	ctx, span := start(ctx, "Service.GetTeamSLA")
+	res, err := s.next.GetTeamSLA(ctx, teamName)
+	return res, end(span, err)
+}
+
+func (s *service) SetTeamSLA(ctx context.Context, teamName string, sla entity.SLA) (entity.TeamSLA, error) {
+	ctx, span := start(ctx, "Service.SetTeamSLA")
+	res, err := s.next.SetTeamSLA(ctx, teamName, sla)
+	return res, end(span, err)
+}
+
+func (s *service) GetTeamEscalation(ctx context.Context, teamName string) (entity.EscalationPolicy, error) {
+	ctx, span := start(ctx, "Service.GetTeamEscalation")
+	res, err := s.next.GetTeamEscalation(ctx, teamName)
+	return res, end(span, err)
+}
+
+func (s *service) SetTeamEscalation(ctx context.Context, policy entity.EscalationPolicy) (entity.EscalationPolicy, error) {
+	ctx, span := start(ctx, "Service.SetTeamEscalation")
+	res, err := s.next.SetTeamEscalation(ctx, policy)
+	return res, end(span, err)
+}
+
+func (s *service) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	ctx, span := start(ctx, "Service.GetUser")
+	res, err := s.next.GetUser(ctx, userID)
+	return res, end(span, err)
+}
+
+func (s *service) SetUserActive(ctx context.Context, userID string, active bool) (entity.User, error) {
+	ctx, span := start(ctx, "Service.SetUserActive")
+	res, err := s.next.SetUserActive(ctx, userID, active)
+	return res, end(span, err)
+}
+
+func (s *service) GetUserReviewPRs(ctx context.Context, userID string) ([]entity.PullRequest, error) {
+	ctx, span := start(ctx, "Service.GetUserReviewPRs")
+	res, err := s.next.GetUserReviewPRs(ctx, userID)
+	return res, end(span, err)
+}
+
+func (s *service) GetUserStats(ctx context.Context, userID string) (entity.UserStats, error) {
+	ctx, span := start(ctx, "Service.GetUserStats")
+	res, err := s.next.GetUserStats(ctx, userID)
+	return res, end(span, err)
+}
+
+func (s *service) GetNotificationPrefs(ctx context.Context, userID string) (entity.NotificationPrefs, error) {
+	ctx, span := start(ctx, "Service.GetNotificationPrefs")
+	res, err := s.next.GetNotificationPrefs(ctx, userID)
+	return res, end(span, err)
+}
+
+func (s *service) SetNotificationPrefs(ctx context.Context, prefs entity.NotificationPrefs) (entity.NotificationPrefs, error) {
+	ctx, span := start(ctx, "Service.SetNotificationPrefs")
+	res, err := s.next.SetNotificationPrefs(ctx, prefs)
+	return res, end(span, err)
+}
+
+func (s *service) CreatePR(ctx context.Context, id, name, authorID string, mode entity.NoCandidateMode) (entity.PullRequest, error) {
+	ctx, span := start(ctx, "Service.CreatePR")
+	res, err := s.next.CreatePR(ctx, id, name, authorID, mode)
+	return res, end(span, err)
+}
+
+func (s *service) GetPR(ctx context.Context, id string) (entity.PullRequest, error) {
+	ctx, span := start(ctx, "Service.GetPR")
+	res, err := s.next.GetPR(ctx, id)
+	return res, end(span, err)
+}
+
+func (s *service) MergePR(ctx context.Context, id string, ifVersion int64) (entity.PullRequest, error) {
+	ctx, span := start(ctx, "Service.MergePR")
+	res, err := s.next.MergePR(ctx, id, ifVersion)
+	return res, end(span, err)
+}
+
+func (s *service) ReassignReviewer(ctx context.Context, prID, oldReviewerID string, ifVersion int64, mode entity.NoCandidateMode) (entity.PullRequest, string, error) {
+	ctx, span := start(ctx, "Service.ReassignReviewer")
+	res, replacedBy, err := s.next.ReassignReviewer(ctx, prID, oldReviewerID, ifVersion, mode)
+	return res, replacedBy, end(span, err)
+}
+
+func (s *service) GetPRStats(ctx context.Context, filter entity.StatsFilter) (entity.PRStats, error) {
+	ctx, span := start(ctx, "Service.GetPRStats")
+	res, err := s.next.GetPRStats(ctx, filter)
+	return res, end(span, err)
+}
+
+func (s *service) RollupStats(ctx context.Context, from, to time.Time) (int, error) {
+	ctx, span := start(ctx, "Service.RollupStats")
+	res, err := s.next.RollupStats(ctx, from, to)
+	return res, end(span, err)
+}
+
+func (s *service) GetTimeseries(ctx context.Context, filter entity.TimeseriesFilter) ([]entity.TimeseriesPoint, error) {
+	ctx, span := start(ctx, "Service.GetTimeseries")
+	res, err := s.next.GetTimeseries(ctx, filter)
+	return res, end(span, err)
+}
+
+func (s *service) GetLeaderboard(ctx context.Context, filter entity.LeaderboardFilter) ([]entity.LeaderboardEntry, error) {
+	ctx, span := start(ctx, "Service.GetLeaderboard")
+	res, err := s.next.GetLeaderboard(ctx, filter)
+	return res, end(span, err)
+}
+
+func (s *service) ListEvents(ctx context.Context, afterID int64, filter entity.EventFilter, limit int) ([]entity.Event, error) {
+	ctx, span := start(ctx, "Service.ListEvents")
+	res, err := s.next.ListEvents(ctx, afterID, filter, limit)
+	return res, end(span, err)
+}
+
+func (s *service) Export(ctx context.Context, emit func(entity.TransferRecord) error) error {
+	ctx, span := start(ctx, "Service.Export")
+	return end(span, s.next.Export(ctx, emit))
+}
+
+func (s *service) Import(ctx context.Context, src usecase.RecordReader, dryRun bool) (entity.ImportResult, error) {
+	ctx, span := start(ctx, "Service.Import")
+	res, err := s.next.Import(ctx, src, dryRun)
+	return res, end(span, err)
+}
+
+// SubscribeEvents не трассируется: подписка живёт всё время SSE-потока
+func (s *service) SubscribeEvents(filter entity.EventFilter) (<-chan entity.Event, func()) {
+	return s.next.SubscribeEvents(filter)
+}
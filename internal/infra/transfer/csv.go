@@ -0,0 +1,250 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+// Колонки CSV: команда занимает по строке на участника (пустая команда — одну строку без user_id),
+// PR — одну строку. Ревьюверы перечисляются через reviewerSep.
+var csvHeader = []string{
+	"kind", "team_name", "user_id", "username", "is_active",
+	"pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "assigned_reviewers",
+}
+
+const reviewerSep = ";"
+
+// CSVWriter пишет записи строками CSV; заголовок — перед первой записью
+type CSVWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w)}
+}
+
+func (w *CSVWriter) Write(rec entity.TransferRecord) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	if t := rec.Team; t != nil {
+		if len(t.Members) == 0 {
+			return w.w.Write(csvRow(map[string]string{"kind": KindTeam, "team_name": t.Name}))
+		}
+		for _, u := range t.Members {
+			err := w.w.Write(csvRow(map[string]string{
+				"kind":      KindTeam,
+				"team_name": t.Name,
+				"user_id":   u.ID,
+				"username":  u.Username,
+				"is_active": strconv.FormatBool(u.IsActive),
+			}))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	pr := rec.PullRequest
+	return w.w.Write(csvRow(map[string]string{
+		"kind":               KindPullRequest,
+		"pull_request_id":    pr.ID,
+		"pull_request_name":  pr.Name,
+		"author_id":          pr.AuthorID,
+		"status":             string(pr.Status),
+		"created_at":         formatTime(pr.CreatedAt),
+		"merged_at":          formatTime(pr.MergedAt),
+		"assigned_reviewers": strings.Join(pr.Reviewers, reviewerSep),
+	}))
+}
+
+// Flush дописывает буфер; в пустой выгрузке остаётся только заголовок
+func (w *CSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *CSVWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.w.Write(csvHeader)
+}
+
+func csvRow(values map[string]string) []string {
+	row := make([]string, len(csvHeader))
+	for i, col := range csvHeader {
+		row[i] = values[col]
+	}
+	return row
+}
+
+// ReadCSV разбирает CSV целиком
+func ReadCSV(r io.Reader) ([]entity.TransferRecord, error) {
+	return readAll(NewCSVReader(r))
+}
+
+// CSVReader разбирает CSV с заголовком; порядок колонок любой, неизвестные колонки — ошибка.
+// Подряд идущие строки одной команды собираются в одну запись, поэтому запись отдаётся,
+// когда прочитана следующая за ней строка.
+type CSVReader struct {
+	cr      *csv.Reader
+	columns map[string]int
+	// pending — запись, которая может продолжиться в следующей строке
+	pending *entity.TransferRecord
+}
+
+func NewCSVReader(r io.Reader) *CSVReader {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	return &CSVReader{cr: cr}
+}
+
+func (r *CSVReader) Read() (entity.TransferRecord, error) {
+	if r.columns == nil {
+		if err := r.readHeader(); err != nil {
+			return entity.TransferRecord{}, err
+		}
+	}
+
+	for {
+		row, err := r.cr.Read()
+		if errors.Is(err, io.EOF) {
+			if r.pending == nil {
+				return entity.TransferRecord{}, io.EOF
+			}
+			rec := *r.pending
+			r.pending = nil
+			return rec, nil
+		}
+		if err != nil {
+			return entity.TransferRecord{}, fmt.Errorf("read csv: %w", err)
+		}
+		line, _ := r.cr.FieldPos(0)
+		get := func(col string) string {
+			if i, ok := r.columns[col]; ok {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		var next *entity.TransferRecord
+		switch kind := get("kind"); kind {
+		case KindTeam:
+			name := get("team_name")
+			var u *entity.User
+			if get("user_id") != "" {
+				active, err := strconv.ParseBool(get("is_active"))
+				if err != nil {
+					return entity.TransferRecord{}, fmt.Errorf("line %d: is_active: expected true or false", line)
+				}
+				u = &entity.User{ID: get("user_id"), Username: get("username"), TeamName: name, IsActive: active}
+			}
+			if p := r.pending; p != nil && p.Team != nil && p.Team.Name == name {
+				if u != nil {
+					p.Team.Members = append(p.Team.Members, *u)
+				}
+				continue
+			}
+			next = &entity.TransferRecord{Line: line, Team: &entity.Team{Name: name, Members: []entity.User{}}}
+			if u != nil {
+				next.Team.Members = append(next.Team.Members, *u)
+			}
+		case KindPullRequest:
+			createdAt, err := parseTime(get("created_at"))
+			if err != nil {
+				return entity.TransferRecord{}, fmt.Errorf("line %d: created_at: %w", line, err)
+			}
+			mergedAt, err := parseTime(get("merged_at"))
+			if err != nil {
+				return entity.TransferRecord{}, fmt.Errorf("line %d: merged_at: %w", line, err)
+			}
+			var reviewers []string
+			if s := get("assigned_reviewers"); s != "" {
+				reviewers = strings.Split(s, reviewerSep)
+			}
+			next = &entity.TransferRecord{Line: line, PullRequest: &entity.PullRequest{
+				ID:        get("pull_request_id"),
+				Name:      get("pull_request_name"),
+				AuthorID:  get("author_id"),
+				Status:    entity.PRStatus(get("status")),
+				CreatedAt: createdAt,
+				MergedAt:  mergedAt,
+				Reviewers: reviewers,
+			}}
+		default:
+			return entity.TransferRecord{}, fmt.Errorf("line %d: %w", line, errUnknownKind(kind))
+		}
+
+		prev := r.pending
+		r.pending = next
+		if prev != nil {
+			return *prev, nil
+		}
+	}
+}
+
+// readHeader читает заголовок; пустой файл — сразу io.EOF
+func (r *CSVReader) readHeader() error {
+	header, err := r.cr.Read()
+	if errors.Is(err, io.EOF) {
+		return io.EOF
+	}
+	if err != nil {
+		return fmt.Errorf("read csv: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !contains(csvHeader, name) {
+			return fmt.Errorf("line 1: unknown column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["kind"]; !ok {
+		return errors.New(`line 1: column "kind" is required`)
+	}
+	r.columns = columns
+	return nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, errors.New("expected RFC 3339 timestamp")
+	}
+	return &t, nil
+}
+
+func contains(slice []string, val string) bool {
+	for _, s := range slice {
+		if s == val {
+			return true
+		}
+	}
+	return false
+}
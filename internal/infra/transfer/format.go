@@ -0,0 +1,106 @@
+package transfer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+const (
+	KindTeam        = "team"
+	KindPullRequest = "pull_request"
+)
+
+// Format — формат файла выгрузки
+type Format string
+
+const (
+	FormatJSONL Format = "jsonl"
+	FormatCSV   Format = "csv"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatJSONL, FormatCSV:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown format %q, expected %q or %q", s, FormatJSONL, FormatCSV)
+}
+
+// FormatFromPath определяет формат по расширению файла; неизвестное расширение — JSONL
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return FormatCSV
+	}
+	return FormatJSONL
+}
+
+// FormatFromContentType — формат тела HTTP-запроса
+func FormatFromContentType(contentType string) (Format, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("invalid content type %q", contentType)
+	}
+	for _, f := range []Format{FormatJSONL, FormatCSV} {
+		if f.ContentType() == mediaType {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported content type %q", mediaType)
+}
+
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv"
+	}
+	return "application/x-ndjson"
+}
+
+// Writer пишет записи выгрузки; Flush дописывает буфер после последней записи
+type Writer interface {
+	Write(rec entity.TransferRecord) error
+	Flush() error
+}
+
+func NewWriter(w io.Writer, f Format) Writer {
+	if f == FormatCSV {
+		return NewCSVWriter(w)
+	}
+	return NewJSONLWriter(w)
+}
+
+// Reader отдаёт записи выгрузки по одной; конец файла — io.EOF
+type Reader interface {
+	Read() (entity.TransferRecord, error)
+}
+
+// NewReader — потоковый разбор: импорт применяет файл пачками, не держа его в памяти целиком
+func NewReader(r io.Reader, f Format) Reader {
+	if f == FormatCSV {
+		return NewCSVReader(r)
+	}
+	return NewJSONLReader(r)
+}
+
+func readAll(r Reader) ([]entity.TransferRecord, error) {
+	var records []entity.TransferRecord
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+}
+
+func errUnknownKind(kind string) error {
+	return fmt.Errorf("unknown kind %q, expected %q or %q", kind, KindTeam, KindPullRequest)
+}
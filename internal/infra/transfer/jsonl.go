@@ -0,0 +1,143 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+)
+
+// Самая длинная строка JSONL, которую примет ReadJSONL (команда на несколько тысяч участников)
+const maxLineSize = 4 << 20
+
+// record — строка выгрузки; имена полей те же, что в REST API
+type record struct {
+	Kind string `json:"kind"`
+
+	TeamName string   `json:"team_name,omitempty"`
+	Members  []member `json:"members,omitempty"`
+
+	PullRequestID     string     `json:"pull_request_id,omitempty"`
+	PullRequestName   string     `json:"pull_request_name,omitempty"`
+	AuthorID          string     `json:"author_id,omitempty"`
+	Status            string     `json:"status,omitempty"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	AssignedReviewers []string   `json:"assigned_reviewers,omitempty"`
+}
+
+type member struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+// JSONLWriter пишет записи по одной JSON-строке
+type JSONLWriter struct {
+	enc *json.Encoder
+}
+
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *JSONLWriter) Write(rec entity.TransferRecord) error {
+	return w.enc.Encode(fromEntity(rec))
+}
+
+// Flush — Encoder пишет каждую запись сразу, буфера нет
+func (w *JSONLWriter) Flush() error {
+	return nil
+}
+
+// ReadJSONL разбирает JSONL целиком
+func ReadJSONL(r io.Reader) ([]entity.TransferRecord, error) {
+	return readAll(NewJSONLReader(r))
+}
+
+// JSONLReader разбирает JSONL по строке; пустые строки пропускаются, ошибка указывает номер строки
+type JSONLReader struct {
+	sc   *bufio.Scanner
+	line int
+}
+
+func NewJSONLReader(r io.Reader) *JSONLReader {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), maxLineSize)
+	return &JSONLReader{sc: sc}
+}
+
+func (r *JSONLReader) Read() (entity.TransferRecord, error) {
+	for r.sc.Scan() {
+		r.line++
+		raw := bytes.TrimSpace(r.sc.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		var rec record
+		if err := dec.Decode(&rec); err != nil {
+			return entity.TransferRecord{}, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		tr, err := rec.toEntity()
+		if err != nil {
+			return entity.TransferRecord{}, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		tr.Line = r.line
+		return tr, nil
+	}
+	if err := r.sc.Err(); err != nil {
+		return entity.TransferRecord{}, fmt.Errorf("read jsonl: %w", err)
+	}
+	return entity.TransferRecord{}, io.EOF
+}
+
+func fromEntity(rec entity.TransferRecord) record {
+	if rec.Team != nil {
+		members := make([]member, len(rec.Team.Members))
+		for i, u := range rec.Team.Members {
+			members[i] = member{UserID: u.ID, Username: u.Username, IsActive: u.IsActive}
+		}
+		return record{Kind: KindTeam, TeamName: rec.Team.Name, Members: members}
+	}
+
+	pr := rec.PullRequest
+	return record{
+		Kind:              KindPullRequest,
+		PullRequestID:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorID:          pr.AuthorID,
+		Status:            string(pr.Status),
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		AssignedReviewers: pr.Reviewers,
+	}
+}
+
+func (r record) toEntity() (entity.TransferRecord, error) {
+	switch r.Kind {
+	case KindTeam:
+		members := make([]entity.User, len(r.Members))
+		for i, m := range r.Members {
+			members[i] = entity.User{ID: m.UserID, Username: m.Username, TeamName: r.TeamName, IsActive: m.IsActive}
+		}
+		return entity.TransferRecord{Team: &entity.Team{Name: r.TeamName, Members: members}}, nil
+	case KindPullRequest:
+		return entity.TransferRecord{PullRequest: &entity.PullRequest{
+			ID:        r.PullRequestID,
+			Name:      r.PullRequestName,
+			AuthorID:  r.AuthorID,
+			Status:    entity.PRStatus(r.Status),
+			CreatedAt: r.CreatedAt,
+			MergedAt:  r.MergedAt,
+			Reviewers: r.AssignedReviewers,
+		}}, nil
+	}
+	return entity.TransferRecord{}, errUnknownKind(r.Kind)
+}
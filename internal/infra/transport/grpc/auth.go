@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/logging"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+var (
+	readers = []entity.Role{entity.RoleAdmin, entity.RoleTeamLead, entity.RoleMember, entity.RoleReadOnly}
+	writers = []entity.Role{entity.RoleAdmin, entity.RoleTeamLead, entity.RoleMember}
+	leads   = []entity.Role{entity.RoleAdmin, entity.RoleTeamLead}
+)
+
+// methodRoles — те же права, что x-roles в api/openapi.yml
+var methodRoles = map[string][]entity.Role{
+	pb.ReviewerService_AddTeam_FullMethodName:               leads,
+	pb.ReviewerService_GetTeam_FullMethodName:               readers,
+	pb.ReviewerService_DeactivateTeamMembers_FullMethodName: leads,
+	pb.ReviewerService_SetUserActive_FullMethodName:         leads,
+	pb.ReviewerService_GetUserReviews_FullMethodName:        readers,
+	pb.ReviewerService_GetUserStats_FullMethodName:          readers,
+	pb.ReviewerService_CreatePullRequest_FullMethodName:     writers,
+	pb.ReviewerService_MergePullRequest_FullMethodName:      writers,
+	pb.ReviewerService_ReassignReviewer_FullMethodName:      writers,
+	pb.ReviewerService_GetPullRequestStats_FullMethodName:   readers,
+	pb.ReviewerService_StreamEvents_FullMethodName:          readers,
+}
+
+// authOptions — интерсепторы аутентификации: x-api-key или authorization: Bearer в metadata,
+// x-tenant-id — как заголовок X-Tenant-ID в REST.
+// Методы не из methodRoles (reflection) доступны без учётных данных.
+func authOptions(authn *auth.Authenticator) []grpclib.ServerOption {
+	return []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			ctx, err := authenticate(ctx, authn, info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpclib.ChainStreamInterceptor(func(srv any, ss grpclib.ServerStream, info *grpclib.StreamServerInfo, handler grpclib.StreamHandler) error {
+			ctx, err := authenticate(ss.Context(), authn, info.FullMethod)
+			if err != nil {
+				return err
+			}
+			return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+		}),
+	}
+}
+
+func authenticate(ctx context.Context, authn *auth.Authenticator, method string) (context.Context, error) {
+	roles, ok := methodRoles[method]
+	if !ok {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	bearer, _ := strings.CutPrefix(first(md, "authorization"), "Bearer ")
+
+	principal, err := authn.Authenticate(ctx, first(md, "x-api-key"), bearer, first(md, "x-tenant-id"))
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	if err := auth.Allow(principal, roles); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	ctx = logging.WithAttrs(ctx, slog.String("grpc_method", method), slog.String("user", principal.Subject),
+		slog.String("tenant", principal.TenantID))
+	return tenant.NewContext(auth.NewContext(ctx, principal), principal.TenantID), nil
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// authStream подменяет контекст стрима на контекст с принципалом и арендатором
+type authStream struct {
+	grpclib.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+)
+
+// errorCodes — соответствие доменных ошибок gRPC-кодам
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{usecase.ErrTeamNotFound, codes.NotFound},
+	{usecase.ErrUserNotFound, codes.NotFound},
+	{usecase.ErrPRNotFound, codes.NotFound},
+	{usecase.ErrTeamExists, codes.AlreadyExists},
+	{usecase.ErrPRExists, codes.AlreadyExists},
+	{usecase.ErrAlreadyMerged, codes.FailedPrecondition},
+	{usecase.ErrNotReviewer, codes.FailedPrecondition},
+	{usecase.ErrNoCandidates, codes.FailedPrecondition},
+	{usecase.ErrUserNotInTeam, codes.InvalidArgument},
+	{usecase.ErrConflict, codes.Aborted},
+	// Версия из запроса устарела: повтор без перечитывания не поможет, в отличие от ErrConflict
+	{usecase.ErrVersionMismatch, codes.FailedPrecondition},
+	{usecase.ErrTenantNotFound, codes.NotFound},
+	{auth.ErrUnauthenticated, codes.Unauthenticated},
+	{auth.ErrForbidden, codes.PermissionDenied},
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+}
+
+// toStatus переводит ошибку сервиса в gRPC status.
+// Неизвестные ошибки отдаются как Internal без текста, чтобы не светить детали БД, и логируются
+// с контекстом запроса, как 5xx в REST.
+func toStatus(ctx context.Context, err error) error {
+	for _, m := range errorCodes {
+		if errors.Is(err, m.err) {
+			return status.Error(m.code, m.err.Error())
+		}
+	}
+	method, _ := grpclib.Method(ctx)
+	slog.ErrorContext(ctx, "request failed", "grpc_method", method, "error", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func required(field, value string) error {
+	if value == "" {
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	return nil
+}
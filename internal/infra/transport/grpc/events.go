@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+// Сколько событий читаем из журнала за раз при догоне по after_id
+const replayBatchSize = 500
+
+func (s *Server) StreamEvents(req *pb.StreamEventsRequest, stream grpclib.ServerStreamingServer[pb.Event]) error {
+	if req.GetAfterId() < 0 {
+		return status.Error(codes.InvalidArgument, "after_id must be non-negative")
+	}
+
+	ctx := stream.Context()
+	// Шина общая для всех арендаторов, журнал в БД — нет
+	filter := entity.EventFilter{
+		TeamName: req.GetTeamName(),
+		UserID:   req.GetUserId(),
+		TenantID: tenant.ID(ctx),
+	}
+	lastID := req.GetAfterId()
+
+	// Подписываемся до чтения журнала, чтобы не потерять события между replay и live
+	live, cancel := s.service.SubscribeEvents(filter)
+	defer cancel()
+
+	for {
+		batch, err := s.service.ListEvents(ctx, lastID, filter, replayBatchSize)
+		if err != nil {
+			return toStatus(ctx, err)
+		}
+		for _, e := range batch {
+			if err := stream.Send(toPBEvent(e)); err != nil {
+				return err
+			}
+			lastID = e.ID
+		}
+		if len(batch) < replayBatchSize {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-live:
+			if !ok {
+				// Шина закрыта (shutdown) или клиент отстал
+				return status.Error(codes.Unavailable, "event stream closed, resume with after_id")
+			}
+			if e.ID <= lastID {
+				continue
+			}
+			if err := stream.Send(toPBEvent(e)); err != nil {
+				return err
+			}
+			lastID = e.ID
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+var prStatuses = map[entity.PRStatus]pb.PullRequestStatus{
+	entity.PROpen:   pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN,
+	entity.PRMerged: pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED,
+}
+
+var noCandidateModes = map[pb.NoCandidateMode]entity.NoCandidateMode{
+	pb.NoCandidateMode_NO_CANDIDATE_MODE_EMPTY: entity.NoCandidateEmpty,
+	pb.NoCandidateMode_NO_CANDIDATE_MODE_FAIL:  entity.NoCandidateFail,
+}
+
+// noCandidateMode — по умолчанию PR остаётся без ревьювера, как в REST без on_no_candidate
+func noCandidateMode(m pb.NoCandidateMode) entity.NoCandidateMode {
+	if mode, ok := noCandidateModes[m]; ok {
+		return mode
+	}
+	return entity.NoCandidateEmpty
+}
+
+var eventTypes = map[entity.EventType]pb.EventType{
+	entity.EventPRCreated:          pb.EventType_EVENT_TYPE_PR_CREATED,
+	entity.EventPRMerged:           pb.EventType_EVENT_TYPE_PR_MERGED,
+	entity.EventReviewerAssigned:   pb.EventType_EVENT_TYPE_REVIEWER_ASSIGNED,
+	entity.EventReviewerUnassigned: pb.EventType_EVENT_TYPE_REVIEWER_UNASSIGNED,
+}
+
+func toPBTeam(team entity.Team) *pb.Team {
+	resp := &pb.Team{
+		TeamName: team.Name,
+		Members:  make([]*pb.TeamMember, 0, len(team.Members)),
+	}
+	for _, m := range team.Members {
+		resp.Members = append(resp.Members, &pb.TeamMember{
+			UserId:   m.ID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+	return resp
+}
+
+func toPBUser(user entity.User) *pb.User {
+	return &pb.User{
+		UserId:   user.ID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}
+}
+
+func toPBPullRequest(pr entity.PullRequest) *pb.PullRequest {
+	resp := &pb.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            prStatuses[pr.Status],
+		AssignedReviewers: pr.Reviewers,
+		Version:           pr.Version,
+	}
+	if pr.CreatedAt != nil {
+		resp.CreatedAt = timestamppb.New(*pr.CreatedAt)
+	}
+	if pr.MergedAt != nil {
+		resp.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+	return resp
+}
+
+func toPBEvent(e entity.Event) *pb.Event {
+	return &pb.Event{
+		Id:            e.ID,
+		Type:          eventTypes[e.Type],
+		PullRequestId: e.PRID,
+		AuthorId:      e.AuthorID,
+		TeamName:      e.TeamName,
+		ReviewerId:    e.ReviewerID,
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+func (s *Server) CreatePullRequest(ctx context.Context, req *pb.CreatePullRequestRequest) (*pb.CreatePullRequestResponse, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	if err := required("author_id", req.GetAuthorId()); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.service.CreatePR(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId(), noCandidateMode(req.GetNoCandidateMode()))
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.CreatePullRequestResponse{Pr: toPBPullRequest(pr)}, nil
+}
+
+func (s *Server) MergePullRequest(ctx context.Context, req *pb.MergePullRequestRequest) (*pb.MergePullRequestResponse, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.service.MergePR(ctx, req.GetPullRequestId(), req.GetExpectedVersion())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.MergePullRequestResponse{Pr: toPBPullRequest(pr)}, nil
+}
+
+func (s *Server) ReassignReviewer(ctx context.Context, req *pb.ReassignReviewerRequest) (*pb.ReassignReviewerResponse, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	if err := required("old_user_id", req.GetOldUserId()); err != nil {
+		return nil, err
+	}
+
+	pr, replacedBy, err := s.service.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId(), req.GetExpectedVersion(),
+		noCandidateMode(req.GetNoCandidateMode()))
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.ReassignReviewerResponse{
+		Pr:         toPBPullRequest(pr),
+		ReplacedBy: replacedBy,
+	}, nil
+}
+
+func (s *Server) GetPullRequestStats(ctx context.Context, _ *pb.GetPullRequestStatsRequest) (*pb.GetPullRequestStatsResponse, error) {
+	stats, err := s.service.GetPRStats(ctx, entity.StatsFilter{})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.GetPullRequestStatsResponse{
+		Stats: &pb.PullRequestStats{
+			Total:             int64(stats.Total),
+			Open:              int64(stats.Open),
+			Merged:            int64(stats.Merged),
+			AvgReviewers:      stats.AvgReviewers,
+			AvgMergeTimeHours: stats.AvgMergeTimeHours,
+		},
+	}, nil
+}
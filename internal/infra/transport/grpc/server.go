@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+// Server реализует pb.ReviewerServiceServer поверх того же usecase.Service, что и REST
+type Server struct {
+	pb.UnimplementedReviewerServiceServer
+	service usecase.Service
+}
+
+// NewServer создаёт gRPC-сервер с зарегистрированным ReviewerService, аутентификацией и reflection (для grpcurl)
+func NewServer(service usecase.Service, authn *auth.Authenticator, opts ...grpclib.ServerOption) *grpclib.Server {
+	srv := grpclib.NewServer(append(authOptions(authn), opts...)...)
+	pb.RegisterReviewerServiceServer(srv, &Server{service: service})
+	reflection.Register(srv)
+	return srv
+}
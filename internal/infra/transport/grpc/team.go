@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+func (s *Server) AddTeam(ctx context.Context, req *pb.AddTeamRequest) (*pb.AddTeamResponse, error) {
+	team := req.GetTeam()
+	if err := required("team.team_name", team.GetTeamName()); err != nil {
+		return nil, err
+	}
+	if err := auth.CheckTeam(ctx, team.GetTeamName()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	teamEntity := entity.Team{
+		Name:    team.GetTeamName(),
+		Members: make([]entity.User, 0, len(team.GetMembers())),
+	}
+	for _, m := range team.GetMembers() {
+		if err := required("team.members.user_id", m.GetUserId()); err != nil {
+			return nil, err
+		}
+		teamEntity.Members = append(teamEntity.Members, entity.User{
+			ID:       m.GetUserId(),
+			TeamName: team.GetTeamName(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+		})
+	}
+
+	created, err := s.service.AddOrUpdateTeam(ctx, teamEntity)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.AddTeamResponse{Team: toPBTeam(created)}, nil
+}
+
+func (s *Server) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.GetTeamResponse, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+
+	team, err := s.service.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.GetTeamResponse{Team: toPBTeam(team)}, nil
+}
+
+func (s *Server) DeactivateTeamMembers(ctx context.Context, req *pb.DeactivateTeamMembersRequest) (*pb.DeactivateTeamMembersResponse, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	if err := auth.CheckTeam(ctx, req.GetTeamName()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	if err := s.service.DeactivateUsersAndReassign(ctx, req.GetTeamName(), req.GetUserIds(), 0); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.DeactivateTeamMembersResponse{}, nil
+}
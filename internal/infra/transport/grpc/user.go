@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+func (s *Server) SetUserActive(ctx context.Context, req *pb.SetUserActiveRequest) (*pb.SetUserActiveResponse, error) {
+	if err := required("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	// team_lead может менять только пользователей своей команды
+	current, err := s.service.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	if err := auth.CheckTeam(ctx, current.TeamName); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	user, err := s.service.SetUserActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.SetUserActiveResponse{User: toPBUser(user)}, nil
+}
+
+func (s *Server) GetUserReviews(ctx context.Context, req *pb.GetUserReviewsRequest) (*pb.GetUserReviewsResponse, error) {
+	if err := required("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	prs, err := s.service.GetUserReviewPRs(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	resp := &pb.GetUserReviewsResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*pb.PullRequest, 0, len(prs)),
+	}
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, toPBPullRequest(pr))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetUserStats(ctx context.Context, req *pb.GetUserStatsRequest) (*pb.GetUserStatsResponse, error) {
+	if err := required("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.service.GetUserStats(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.GetUserStatsResponse{
+		Stats: &pb.UserStats{
+			UserId:          stats.UserID,
+			CreatedPrCount:  int64(stats.CreatedPRCount),
+			ReviewedPrCount: int64(stats.ReviewedPRCount),
+			MergedPrCount:   int64(stats.MergedPRCount),
+		},
+	}, nil
+}
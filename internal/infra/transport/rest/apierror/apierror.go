@@ -0,0 +1,92 @@
+package apierror
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// Error — ошибка, готовая к отдаче клиенту.
+// Err — внутренняя причина: пишется в лог и никогда не уходит в ответ.
+type Error struct {
+	Status  int
+	Code    gen.ErrorCode
+	Message string
+	Details []gen.FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Validation — 400 VALIDATION_ERROR с перечнем полей
+func Validation(message string, details ...gen.FieldError) *Error {
+	return &Error{
+		Status:  http.StatusBadRequest,
+		Code:    gen.VALIDATIONERROR,
+		Message: message,
+		Details: details,
+	}
+}
+
+// Internal — 500 INTERNAL, клиенту уходит только общий текст
+func Internal(err error) *Error {
+	return &Error{
+		Status:  http.StatusInternalServerError,
+		Code:    gen.INTERNAL,
+		Message: "internal server error",
+		Err:     err,
+	}
+}
+
+// domainErrors — соответствие ошибок usecase HTTP-статусам и кодам API
+var domainErrors = []struct {
+	err     error
+	status  int
+	code    gen.ErrorCode
+	message string
+}{
+	{usecase.ErrTeamNotFound, http.StatusNotFound, gen.NOTFOUND, "team not found"},
+	{usecase.ErrUserNotFound, http.StatusNotFound, gen.NOTFOUND, "user not found"},
+	{usecase.ErrPRNotFound, http.StatusNotFound, gen.NOTFOUND, "pull request not found"},
+	{usecase.ErrTeamExists, http.StatusBadRequest, gen.TEAMEXISTS, "team_name already exists"},
+	{usecase.ErrPRExists, http.StatusConflict, gen.PREXISTS, "PR id already exists"},
+	{usecase.ErrAlreadyMerged, http.StatusConflict, gen.PRMERGED, "cannot reassign on merged PR"},
+	{usecase.ErrNotReviewer, http.StatusConflict, gen.NOTASSIGNED, "reviewer is not assigned to this PR"},
+	{usecase.ErrNoCandidates, http.StatusConflict, gen.NOCANDIDATE, "no active replacement candidate in team"},
+	{usecase.ErrUserNotInTeam, http.StatusBadRequest, gen.VALIDATIONERROR, "user does not belong to the team"},
+	{usecase.ErrConflict, http.StatusConflict, gen.CONFLICT, "concurrent modification, retry the request"},
+	{usecase.ErrVersionMismatch, http.StatusPreconditionFailed, gen.PRECONDITIONFAILED, "resource was modified, re-read it and retry"},
+	{usecase.ErrImportConflict, http.StatusConflict, gen.CONFLICT, "import has conflicts, nothing was written"},
+	{usecase.ErrTeamCycle, http.StatusConflict, gen.CONFLICT, "team cannot be moved under itself or its subteam"},
+	{usecase.ErrTenantNotFound, http.StatusNotFound, gen.NOTFOUND, "tenant not found"},
+	{auth.ErrUnauthenticated, http.StatusUnauthorized, gen.UNAUTHORIZED, "authentication required"},
+	{auth.ErrForbidden, http.StatusForbidden, gen.FORBIDDEN, "access denied"},
+}
+
+// From приводит любую ошибку к *Error. Неизвестные ошибки становятся INTERNAL.
+func From(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	for _, m := range domainErrors {
+		if errors.Is(err, m.err) {
+			return &Error{Status: m.status, Code: m.code, Message: m.message, Err: err}
+		}
+	}
+
+	return Internal(err)
+}
@@ -0,0 +1,76 @@
+package apierror
+
+import (
+	"encoding/json"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/requestid"
+)
+
+const problemContentType = "application/problem+json"
+
+// Write отдаёт ошибку клиенту: application/problem+json (RFC 7807), если клиент
+// его запросил в Accept, иначе обычный ErrorResponse. Ошибки 5xx логируются с контекстом запроса.
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	e := From(err)
+	reqID := requestid.FromContext(r.Context())
+
+	if e.Status >= http.StatusInternalServerError {
+		slog.ErrorContext(r.Context(), "request failed", "method", r.Method, "path", r.URL.Path, "error", e)
+	}
+
+	if wantsProblem(r) {
+		writeProblem(w, r, e, reqID)
+		return
+	}
+
+	var resp gen.ErrorResponse
+	resp.Error.Code = e.Code
+	resp.Error.Message = e.Message
+	if reqID != "" {
+		resp.Error.RequestId = &reqID
+	}
+	if len(e.Details) > 0 {
+		resp.Error.Details = &e.Details
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.Status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, e *Error, reqID string) {
+	instance := r.URL.Path
+	resp := gen.Problem{
+		Type:     "urn:pr-reviewer:error:" + strings.ToLower(string(e.Code)),
+		Title:    http.StatusText(e.Status),
+		Status:   e.Status,
+		Detail:   &e.Message,
+		Instance: &instance,
+		Code:     e.Code,
+	}
+	if reqID != "" {
+		resp.RequestId = &reqID
+	}
+	if len(e.Details) > 0 {
+		resp.Errors = &e.Details
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(e.Status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func wantsProblem(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == problemContentType {
+			return true
+		}
+	}
+	return false
+}
@@ -5,16 +5,62 @@ package gen
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for ErrorCode.
+const (
+	CONFLICT             ErrorCode = "CONFLICT"
+	FORBIDDEN            ErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYREUSED ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNAL             ErrorCode = "INTERNAL"
+	NOCANDIDATE          ErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED          ErrorCode = "NOT_ASSIGNED"
+	NOTFOUND             ErrorCode = "NOT_FOUND"
+	PRECONDITIONFAILED   ErrorCode = "PRECONDITION_FAILED"
+	PREXISTS             ErrorCode = "PR_EXISTS"
+	PRMERGED             ErrorCode = "PR_MERGED"
+	TEAMEXISTS           ErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED         ErrorCode = "UNAUTHORIZED"
+	VALIDATIONERROR      ErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for HealthComponentStatus.
+const (
+	HealthComponentStatusFail HealthComponentStatus = "fail"
+	HealthComponentStatusOk   HealthComponentStatus = "ok"
+)
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusFail HealthReportStatus = "fail"
+	HealthReportStatusOk   HealthReportStatus = "ok"
 )
 
-// Defines values for ErrorResponseErrorCode.
+// Defines values for ImportConflictKind.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ImportConflictKindPullRequest ImportConflictKind = "pull_request"
+	ImportConflictKindTeam        ImportConflictKind = "team"
+	ImportConflictKindUser        ImportConflictKind = "user"
+)
+
+// Defines values for NoCandidateMode.
+const (
+	NoCandidateEmpty NoCandidateMode = "EMPTY"
+	NoCandidateFail  NoCandidateMode = "NO_CANDIDATE"
+)
+
+// Defines values for NotificationChannel.
+const (
+	Chat  NotificationChannel = "chat"
+	Email NotificationChannel = "email"
+	File  NotificationChannel = "file"
 )
 
 // Defines values for PullRequestStatus.
@@ -29,23 +75,206 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for GetAdminExportParamsFormat.
+const (
+	GetAdminExportParamsFormatCsv   GetAdminExportParamsFormat = "csv"
+	GetAdminExportParamsFormatJsonl GetAdminExportParamsFormat = "jsonl"
+)
+
+// Defines values for GetStatsTimeseriesParamsGranularity.
+const (
+	Day   GetStatsTimeseriesParamsGranularity = "day"
+	Month GetStatsTimeseriesParamsGranularity = "month"
+	Week  GetStatsTimeseriesParamsGranularity = "week"
+)
+
+// Defines values for GetUsersLeaderboardParamsSortBy.
+const (
+	Completed    GetUsersLeaderboardParamsSortBy = "completed"
+	Open         GetUsersLeaderboardParamsSortBy = "open"
+	ReassignRate GetUsersLeaderboardParamsSortBy = "reassign_rate"
+	Turnaround   GetUsersLeaderboardParamsSortBy = "turnaround"
+)
+
+// Defines values for GetUsersLeaderboardParamsFormat.
+const (
+	GetUsersLeaderboardParamsFormatCsv  GetUsersLeaderboardParamsFormat = "csv"
+	GetUsersLeaderboardParamsFormatJson GetUsersLeaderboardParamsFormat = "json"
+)
+
+// DurationStats Распределение длительностей в часах. merge_time — от создания PR до merge, first_review_time —
+// от PR_CREATED до первого REVIEWER_ASSIGNED после него по журналу событий (PR без назначений,
+// созданные до появления журнала или загруженные импортом, не учитываются). При count = 0 остальные поля отсутствуют.
+type DurationStats struct {
+	AvgHours *float64 `json:"avg_hours,omitempty"`
+
+	// Count По скольким PR посчитано
+	Count       int      `json:"count"`
+	MedianHours *float64 `json:"median_hours,omitempty"`
+	P90Hours    *float64 `json:"p90_hours,omitempty"`
+	P99Hours    *float64 `json:"p99_hours,omitempty"`
+}
+
+// ErrorCode defines model for ErrorCode.
+type ErrorCode string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code    ErrorCode     `json:"code"`
+		Details *[]FieldError `json:"details,omitempty"`
+		Message string        `json:"message"`
+
+		// RequestId Совпадает с заголовком X-Request-ID ответа
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
-type ErrorResponseErrorCode string
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю тела запроса (через точку) или имя параметра
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// HealthComponent defines model for HealthComponent.
+type HealthComponent struct {
+	Message *string               `json:"message,omitempty"`
+	Status  HealthComponentStatus `json:"status"`
+}
+
+// HealthComponentStatus defines model for HealthComponent.Status.
+type HealthComponentStatus string
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	// Components database, migrations, server
+	Components map[string]HealthComponent `json:"components"`
+	Status     HealthReportStatus         `json:"status"`
+}
+
+// HealthReportStatus defines model for HealthReport.Status.
+type HealthReportStatus string
+
+// ImportConflict defines model for ImportConflict.
+type ImportConflict struct {
+	Id   string             `json:"id"`
+	Kind ImportConflictKind `json:"kind"`
+
+	// Line Строка файла, с которой начинается запись
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// ImportConflictKind defines model for ImportConflict.Kind.
+type ImportConflictKind string
+
+// ImportCounts defines model for ImportCounts.
+type ImportCounts struct {
+	Created   int `json:"created"`
+	Unchanged int `json:"unchanged"`
+	Updated   int `json:"updated"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Conflicts    []ImportConflict `json:"conflicts"`
+	DryRun       bool             `json:"dry_run"`
+	PullRequests ImportCounts     `json:"pull_requests"`
+	Teams        ImportCounts     `json:"teams"`
+	Users        ImportCounts     `json:"users"`
+}
+
+// LeaderboardEntry Нагрузка ревьювера за период. Ревью завершено, если PR смержен в периоде, пока пользователь
+// оставался его ревьювером; open_reviews — текущие открытые ревью без учёта периода.
+type LeaderboardEntry struct {
+	// AssignmentsReceived Назначения за период по журналу событий
+	AssignmentsReceived int `json:"assignments_received"`
+
+	// AvgTurnaroundHours Среднее время от назначения до merge по завершённым ревью; null, если их нет
+	AvgTurnaroundHours *float64 `json:"avg_turnaround_hours"`
+	IsActive           bool     `json:"is_active"`
+	OpenReviews        int      `json:"open_reviews"`
+	Rank               int      `json:"rank"`
+
+	// ReassignAwayRate reassigned_away / (reassigned_away + reviews_completed); null, если ни одно ревью не закончилось
+	ReassignAwayRate *float64 `json:"reassign_away_rate"`
+
+	// ReassignedAway Сколько раз пользователя сняли с ревью за период
+	ReassignedAway   int    `json:"reassigned_away"`
+	ReviewsCompleted int    `json:"reviews_completed"`
+	TeamName         string `json:"team_name"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
+// MemberLoad defines model for MemberLoad.
+type MemberLoad struct {
+	IsActive bool `json:"is_active"`
+
+	// OpenReviews Открытые PR, где участник — ревьювер
+	OpenReviews int `json:"open_reviews"`
+
+	// TeamName Команда участника — отличается от запрошенной, если статистика с include_subteams
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// NoCandidateMode Что делать, если ревьювера не нашлось ни в команде, ни по правилам эскалации (лид, команда эскалации):
+// EMPTY — оставить PR без ревьювера (при переназначении — снять старого), NO_CANDIDATE — ответить 409
+// NO_CANDIDATE и ничего не менять.
+type NoCandidateMode string
+
+// NotificationChannel email — письмо на адрес из настроек, chat — входящий webhook чата, file — JSON-строка в файл или stdout
+type NotificationChannel string
+
+// NotificationPrefs Как пользователь узнаёт о назначении ревьювером. Без сохранённых настроек действуют каналы
+// из конфигурации сервиса (notify.assignment_channels).
+type NotificationPrefs struct {
+	Channels []NotificationChannel `json:"channels"`
+
+	// Email Адрес для канала email
+	Email *string `json:"email,omitempty"`
+
+	// IsDefault Пользователь не сохранял настроек
+	IsDefault bool `json:"is_default"`
+
+	// QuietHours Время суток, когда уведомления о назначениях не отправляются; start позже end — через полночь
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+	UserId     string      `json:"user_id"`
+}
 
 // PRStats defines model for PRStats.
 type PRStats struct {
 	AvgReviewers *float32 `json:"avg_reviewers"`
-	Merged       int      `json:"merged"`
-	Open         int      `json:"open"`
-	Total        int      `json:"total"`
+
+	// FirstReviewTime Распределение длительностей в часах. merge_time — от создания PR до merge, first_review_time —
+	// от PR_CREATED до первого REVIEWER_ASSIGNED после него по журналу событий (PR без назначений,
+	// созданные до появления журнала или загруженные импортом, не учитываются). При count = 0 остальные поля отсутствуют.
+	FirstReviewTime *DurationStats `json:"first_review_time,omitempty"`
+
+	// MergeTime Распределение длительностей в часах. merge_time — от создания PR до merge, first_review_time —
+	// от PR_CREATED до первого REVIEWER_ASSIGNED после него по журналу событий (PR без назначений,
+	// созданные до появления журнала или загруженные импортом, не учитываются). При count = 0 остальные поля отсутствуют.
+	MergeTime *DurationStats `json:"merge_time,omitempty"`
+	Merged    int            `json:"merged"`
+	Open      int            `json:"open"`
+	Total     int            `json:"total"`
+}
+
+// Problem Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type Problem struct {
+	Code      ErrorCode     `json:"code"`
+	Detail    *string       `json:"detail,omitempty"`
+	Errors    *[]FieldError `json:"errors,omitempty"`
+	Instance  *string       `json:"instance,omitempty"`
+	RequestId *string       `json:"request_id,omitempty"`
+	Status    int           `json:"status"`
+	Title     string        `json:"title"`
+
+	// Type URN вида urn:pr-reviewer:error:<code>
+	Type string `json:"type"`
 }
 
 // PullRequest defines model for PullRequest.
@@ -74,10 +303,44 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// QuietHours Время суток, когда уведомления о назначениях не отправляются; start позже end — через полночь
+type QuietHours struct {
+	End   string `json:"end"`
+	Start string `json:"start"`
+
+	// TimeZone Часовой пояс IANA
+	TimeZone string `json:"time_zone"`
+}
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	Members []TeamMember `json:"members"`
+
+	// ParentTeam Команда уровнем выше (отдел, организация); задаётся через /team/parent
+	ParentTeam *string `json:"parent_team,omitempty"`
+	TeamName   string  `json:"team_name"`
+}
+
+// TeamCounts defines model for TeamCounts.
+type TeamCounts struct {
+	ActiveMembers int `json:"active_members"`
+	Members       int `json:"members"`
+	MergedPrs     int `json:"merged_prs"`
+
+	// OpenPrs Открытые PR авторов-участников
+	OpenPrs int `json:"open_prs"`
+
+	// OpenReviews Назначения участников ревьюверами на открытые PR
+	OpenReviews int `json:"open_reviews"`
+}
+
+// TeamEscalation К кому идти за ревьювером, когда в команде не осталось активных кандидатов: сначала к лиду
+// (если он активен и не автор), затем к активным участникам команды эскалации, а после — к участникам
+// родительских команд, от ближайшей вверх. Отсутствующее поле — шаг пропускается.
+type TeamEscalation struct {
+	EscalationTeam *string `json:"escalation_team,omitempty"`
+	LeadUserId     *string `json:"lead_user_id,omitempty"`
+	TeamName       string  `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
@@ -87,6 +350,61 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamNode defines model for TeamNode.
+type TeamNode struct {
+	Own        TeamCounts `json:"own"`
+	ParentTeam *string    `json:"parent_team,omitempty"`
+	Subteams   []TeamNode `json:"subteams"`
+	TeamName   string     `json:"team_name"`
+	Total      TeamCounts `json:"total"`
+}
+
+// TeamSLA Сроки для открытых PR авторов команды. Планировщик (stale.interval) напоминает ревьюверам
+// о PR старше remind_after_hours (повторно — с тем же интервалом) и заменяет ревьювера,
+// который держит PR дольше reassign_after_hours (0 — не заменять).
+type TeamSLA struct {
+	// IsDefault У команды нет своего SLA, действуют значения из конфигурации сервиса
+	IsDefault          bool    `json:"is_default"`
+	ReassignAfterHours float64 `json:"reassign_after_hours"`
+	RemindAfterHours   float64 `json:"remind_after_hours"`
+	TeamName           string  `json:"team_name"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	// IncludeSubteams Статистика по команде вместе со всеми дочерними
+	IncludeSubteams bool `json:"include_subteams"`
+
+	// LoadGini Коэффициент Джини открытых ревью среди активных участников: 0 — нагрузка поровну,
+	// ближе к 1 — почти все ревью на одном человеке.
+	LoadGini float64 `json:"load_gini"`
+
+	// Members Все участники, по убыванию открытых ревью
+	Members      []MemberLoad `json:"members"`
+	PullRequests PRStats      `json:"pull_requests"`
+
+	// RequiredReviewers Сколько ревьюверов сервис назначает на PR (reviewers.per_pr)
+	RequiredReviewers int    `json:"required_reviewers"`
+	TeamName          string `json:"team_name"`
+
+	// Understaffed Открытые PR команды, где ревьюверов меньше required_reviewers
+	Understaffed []PullRequest `json:"understaffed"`
+}
+
+// TimeseriesPoint Агрегаты за интервал, начинающийся в start (неделя — с понедельника). Интервалы без активности
+// присутствуют с нулями. Для пользователя PR считаются по автору, назначения — по ревьюверу.
+type TimeseriesPoint struct {
+	// MedianMergeHours Медиана времени до merge по PR, смерженным в интервале; null, если таких нет
+	MedianMergeHours *float64 `json:"median_merge_hours"`
+	PrsMerged        int      `json:"prs_merged"`
+	PrsOpened        int      `json:"prs_opened"`
+
+	// Reassignments Сколько раз ревьювер снят с PR (переназначение или деактивация)
+	Reassignments   int                `json:"reassignments"`
+	ReviewsAssigned int                `json:"reviews_assigned"`
+	Start           openapi_types.Date `json:"start"`
+}
+
 // User defines model for User.
 type User struct {
 	IsActive bool   `json:"is_active"`
@@ -103,17 +421,117 @@ type UserStats struct {
 	UserId          string `json:"user_id"`
 }
 
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// ConflictApplicationJSON defines model for Conflict.
+type ConflictApplicationJSON = ErrorResponse
+
+// ConflictApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type ConflictApplicationProblemPlusJSON = Problem
+
+// ForbiddenApplicationJSON defines model for Forbidden.
+type ForbiddenApplicationJSON = ErrorResponse
+
+// ForbiddenApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type ForbiddenApplicationProblemPlusJSON = Problem
+
+// IdempotencyKeyReusedApplicationJSON defines model for IdempotencyKeyReused.
+type IdempotencyKeyReusedApplicationJSON = ErrorResponse
+
+// IdempotencyKeyReusedApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type IdempotencyKeyReusedApplicationProblemPlusJSON = Problem
+
+// InternalErrorApplicationJSON defines model for InternalError.
+type InternalErrorApplicationJSON = ErrorResponse
+
+// InternalErrorApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type InternalErrorApplicationProblemPlusJSON = Problem
+
+// PreconditionFailedApplicationJSON defines model for PreconditionFailed.
+type PreconditionFailedApplicationJSON = ErrorResponse
+
+// PreconditionFailedApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type PreconditionFailedApplicationProblemPlusJSON = Problem
+
+// UnauthorizedApplicationJSON defines model for Unauthorized.
+type UnauthorizedApplicationJSON = ErrorResponse
+
+// UnauthorizedApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type UnauthorizedApplicationProblemPlusJSON = Problem
+
+// ValidationErrorApplicationJSON defines model for ValidationError.
+type ValidationErrorApplicationJSON = ErrorResponse
+
+// ValidationErrorApplicationProblemPlusJSON Ошибка в формате RFC 7807, отдаётся при Accept application/problem+json
+type ValidationErrorApplicationProblemPlusJSON = Problem
+
+// GetAdminExportParams defines parameters for GetAdminExport.
+type GetAdminExportParams struct {
+	Format *GetAdminExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAdminExportParamsFormat defines parameters for GetAdminExport.
+type GetAdminExportParamsFormat string
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// TeamName Только события PR авторов из этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// UserId Только события, где пользователь — автор или ревьювер
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// LastEventID id последнего полученного события
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// OnNoCandidate Что делать, если ревьювера не нашлось ни в команде, ни по правилам эскалации (лид, команда эскалации):
+	// EMPTY — оставить PR без ревьювера (при переназначении — снять старого), NO_CANDIDATE — ответить 409
+	// NO_CANDIDATE и ничего не менять.
+	OnNoCandidate   *NoCandidateMode `json:"on_no_candidate,omitempty"`
+	PullRequestId   string           `json:"pull_request_id"`
+	PullRequestName string           `json:"pull_request_name"`
+}
+
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -121,10 +539,98 @@ type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag, полученный при чтении ресурса (или `*`). Если ресурс с тех пор изменился —
+	// 412 PRECONDITION_FAILED, изменение не применяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+	OldUserId string `json:"old_user_id"`
+
+	// OnNoCandidate Что делать, если ревьювера не нашлось ни в команде, ни по правилам эскалации (лид, команда эскалации):
+	// EMPTY — оставить PR без ревьювера (при переназначении — снять старого), NO_CANDIDATE — ответить 409
+	// NO_CANDIDATE и ничего не менять.
+	OnNoCandidate *NoCandidateMode `json:"on_no_candidate,omitempty"`
+	PullRequestId string           `json:"pull_request_id"`
+}
+
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag, полученный при чтении ресурса (или `*`). Если ресурс с тех пор изменился —
+	// 412 PRECONDITION_FAILED, изменение не применяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPullRequestStatsParams defines parameters for GetPullRequestStats.
+type GetPullRequestStatsParams struct {
+	// TeamName Только PR авторов из этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From PR, созданные не раньше этого момента
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To PR, созданные раньше этого момента
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsTimeseriesParams defines parameters for GetStatsTimeseries.
+type GetStatsTimeseriesParams struct {
+	Granularity *GetStatsTimeseriesParamsGranularity `form:"granularity,omitempty" json:"granularity,omitempty"`
+
+	// From Первый день периода; по умолчанию — за 90 дней до to
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To День после периода (не включается); по умолчанию — завтра
+	To       *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	TeamName *string             `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// UserId Ряд по пользователю; нельзя вместе с team_name
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetStatsTimeseriesParamsGranularity defines parameters for GetStatsTimeseries.
+type GetStatsTimeseriesParamsGranularity string
+
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTeamEscalationParams defines parameters for GetTeamEscalation.
+type GetTeamEscalationParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamEscalationJSONBody defines parameters for PostTeamEscalation.
+type PostTeamEscalationJSONBody struct {
+	EscalationTeam *string `json:"escalation_team,omitempty"`
+	LeadUserId     *string `json:"lead_user_id,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// PostTeamEscalationParams defines parameters for PostTeamEscalation.
+type PostTeamEscalationParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // GetTeamGetParams defines parameters for GetTeamGet.
@@ -133,24 +639,140 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamParentJSONBody defines parameters for PostTeamParent.
+type PostTeamParentJSONBody struct {
+	ParentTeam *string `json:"parent_team,omitempty"`
+	TeamName   string  `json:"team_name"`
+}
+
+// PostTeamParentParams defines parameters for PostTeamParent.
+type PostTeamParentParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTeamSlaParams defines parameters for GetTeamSla.
+type GetTeamSlaParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamSlaJSONBody defines parameters for PostTeamSla.
+type PostTeamSlaJSONBody struct {
+	ReassignAfterHours *float64 `json:"reassign_after_hours,omitempty"`
+	RemindAfterHours   float64  `json:"remind_after_hours"`
+	TeamName           string   `json:"team_name"`
+}
+
+// PostTeamSlaParams defines parameters for PostTeamSla.
+type PostTeamSlaParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTeamStatsParams defines parameters for GetTeamStats.
+type GetTeamStatsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// IncludeSubteams Включить все дочерние команды на всех уровнях
+	IncludeSubteams *bool `form:"include_subteams,omitempty" json:"include_subteams,omitempty"`
+}
+
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Корень поддерева
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
 // PatchTeamsTeamNameDeactivateMembersJSONBody defines parameters for PatchTeamsTeamNameDeactivateMembers.
 type PatchTeamsTeamNameDeactivateMembersJSONBody struct {
 	// UserIds Список user_id для деактивации
 	UserIds []string `json:"user_ids"`
 }
 
+// PatchTeamsTeamNameDeactivateMembersParams defines parameters for PatchTeamsTeamNameDeactivateMembers.
+type PatchTeamsTeamNameDeactivateMembersParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag, полученный при чтении ресурса (или `*`). Если ресурс с тех пор изменился —
+	// 412 PRECONDITION_FAILED, изменение не применяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// GetUsersLeaderboardParams defines parameters for GetUsersLeaderboard.
+type GetUsersLeaderboardParams struct {
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало периода; по умолчанию — за 30 дней до to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включается); по умолчанию — текущий момент
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// SortBy completed и open — по убыванию, turnaround и reassign_rate — по возрастанию (null в конце);
+	// при равенстве — по user_id
+	SortBy *GetUsersLeaderboardParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+	Format *GetUsersLeaderboardParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUsersLeaderboardParamsSortBy defines parameters for GetUsersLeaderboard.
+type GetUsersLeaderboardParamsSortBy string
+
+// GetUsersLeaderboardParamsFormat defines parameters for GetUsersLeaderboard.
+type GetUsersLeaderboardParamsFormat string
+
+// GetUsersNotificationsParams defines parameters for GetUsersNotifications.
+type GetUsersNotificationsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersNotificationsJSONBody defines parameters for PostUsersNotifications.
+type PostUsersNotificationsJSONBody struct {
+	Channels []NotificationChannel `json:"channels"`
+	Email    *openapi_types.Email  `json:"email,omitempty"`
+
+	// QuietHours Время суток, когда уведомления о назначениях не отправляются; start позже end — через полночь
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+	UserId     string      `json:"user_id"`
+}
+
+// PostUsersNotificationsParams defines parameters for PostUsersNotifications.
+type PostUsersNotificationsParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetIsActiveParams defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение TTL
+	// возвращает сохранённый ответ (с заголовком Idempotent-Replayed), с другим телом — 422.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetUsersStatsParams defines parameters for GetUsersStats.
 type GetUsersStatsParams struct {
 	// UserId Идентификатор пользователя
@@ -169,8 +791,20 @@ type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamEscalationJSONRequestBody defines body for PostTeamEscalation for application/json ContentType.
+type PostTeamEscalationJSONRequestBody PostTeamEscalationJSONBody
+
+// PostTeamParentJSONRequestBody defines body for PostTeamParent for application/json ContentType.
+type PostTeamParentJSONRequestBody PostTeamParentJSONBody
+
+// PostTeamSlaJSONRequestBody defines body for PostTeamSla for application/json ContentType.
+type PostTeamSlaJSONRequestBody PostTeamSlaJSONBody
+
 // PatchTeamsTeamNameDeactivateMembersJSONRequestBody defines body for PatchTeamsTeamNameDeactivateMembers for application/json ContentType.
 type PatchTeamsTeamNameDeactivateMembersJSONRequestBody PatchTeamsTeamNameDeactivateMembersJSONBody
 
+// PostUsersNotificationsJSONRequestBody defines body for PostUsersNotifications for application/json ContentType.
+type PostUsersNotificationsJSONRequestBody PostUsersNotificationsJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
@@ -6,6 +6,7 @@ package gen
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -20,36 +21,87 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Health check endpoint
+	// Выгрузка всех команд (с участниками) и PR (с ревьюверами)
+	// (GET /admin/export)
+	GetAdminExport(w http.ResponseWriter, r *http.Request, params GetAdminExportParams)
+	// Загрузка команд, пользователей и PR из выгрузки
+	// (POST /admin/import)
+	PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams)
+	// Поток событий по PR и назначениям ревьюверов (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams)
+	// Health check endpoint (то же, что /health/live)
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Liveness — процесс жив, зависимости не проверяются
+	// (GET /health/live)
+	GetHealthLive(w http.ResponseWriter, r *http.Request)
+	// Readiness — БД доступна, схема нужной версии, сервер не останавливается
+	// (GET /health/ready)
+	GetHealthReady(w http.ResponseWriter, r *http.Request)
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams)
+	// Получить PR с назначенными ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
 	// Получить агрегированную статистику по PR
 	// (GET /pullRequest/stats)
-	GetPullRequestStats(w http.ResponseWriter, r *http.Request)
+	GetPullRequestStats(w http.ResponseWriter, r *http.Request, params GetPullRequestStatsParams)
+	// Временной ряд активности по дням, неделям или месяцам
+	// (GET /stats/timeseries)
+	GetStatsTimeseries(w http.ResponseWriter, r *http.Request, params GetStatsTimeseriesParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams)
+	// Правила эскалации команды, когда в ней нет кандидатов в ревьюверы
+	// (GET /team/escalation)
+	GetTeamEscalation(w http.ResponseWriter, r *http.Request, params GetTeamEscalationParams)
+	// Задать правила эскалации команды
+	// (POST /team/escalation)
+	PostTeamEscalation(w http.ResponseWriter, r *http.Request, params PostTeamEscalationParams)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Перенести команду под другую (отдел, организация) или на верхний уровень
+	// (POST /team/parent)
+	PostTeamParent(w http.ResponseWriter, r *http.Request, params PostTeamParentParams)
+	// SLA команды для открытых PR
+	// (GET /team/sla)
+	GetTeamSla(w http.ResponseWriter, r *http.Request, params GetTeamSlaParams)
+	// Задать SLA команды
+	// (POST /team/sla)
+	PostTeamSla(w http.ResponseWriter, r *http.Request, params PostTeamSlaParams)
+	// Статистика команды и распределение ревью между участниками
+	// (GET /team/stats)
+	GetTeamStats(w http.ResponseWriter, r *http.Request, params GetTeamStatsParams)
+	// Поддерево команд с показателями, свёрнутыми по иерархии
+	// (GET /team/tree)
+	GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams)
 	// Массовая деактивация пользователей команды с автоматическим переназначением ревьюверов
 	// (PATCH /teams/{teamName}/deactivate-members)
-	PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request, teamName string)
+	PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request, teamName string, params PatchTeamsTeamNameDeactivateMembersParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Рейтинг ревьюверов по нагрузке за период
+	// (GET /users/leaderboard)
+	GetUsersLeaderboard(w http.ResponseWriter, r *http.Request, params GetUsersLeaderboardParams)
+	// Настройки уведомлений пользователя о назначениях
+	// (GET /users/notifications)
+	GetUsersNotifications(w http.ResponseWriter, r *http.Request, params GetUsersNotificationsParams)
+	// Задать настройки уведомлений пользователя
+	// (POST /users/notifications)
+	PostUsersNotifications(w http.ResponseWriter, r *http.Request, params PostUsersNotificationsParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams)
 	// Получить статистику пользователя (созданные PR, ревью, merge)
 	// (GET /users/stats)
 	GetUsersStats(w http.ResponseWriter, r *http.Request, params GetUsersStatsParams)
@@ -59,39 +111,93 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
-// Health check endpoint
+// Выгрузка всех команд (с участниками) и PR (с ревьюверами)
+// (GET /admin/export)
+func (_ Unimplemented) GetAdminExport(w http.ResponseWriter, r *http.Request, params GetAdminExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузка команд, пользователей и PR из выгрузки
+// (POST /admin/import)
+func (_ Unimplemented) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поток событий по PR и назначениям ревьюверов (Server-Sent Events)
+// (GET /events/stream)
+func (_ Unimplemented) GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Health check endpoint (то же, что /health/live)
 // (GET /health)
 func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Liveness — процесс жив, зависимости не проверяются
+// (GET /health/live)
+func (_ Unimplemented) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Readiness — БД доступна, схема нужной версии, сервер не останавливается
+// (GET /health/ready)
+func (_ Unimplemented) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 // (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR с назначенными ревьюверами
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Получить агрегированную статистику по PR
 // (GET /pullRequest/stats)
-func (_ Unimplemented) GetPullRequestStats(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetPullRequestStats(w http.ResponseWriter, r *http.Request, params GetPullRequestStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Временной ряд активности по дням, неделям или месяцам
+// (GET /stats/timeseries)
+func (_ Unimplemented) GetStatsTimeseries(w http.ResponseWriter, r *http.Request, params GetStatsTimeseriesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Создать команду с участниками (создаёт/обновляет пользователей)
 // (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Правила эскалации команды, когда в ней нет кандидатов в ревьюверы
+// (GET /team/escalation)
+func (_ Unimplemented) GetTeamEscalation(w http.ResponseWriter, r *http.Request, params GetTeamEscalationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать правила эскалации команды
+// (POST /team/escalation)
+func (_ Unimplemented) PostTeamEscalation(w http.ResponseWriter, r *http.Request, params PostTeamEscalationParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -101,9 +207,39 @@ func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Перенести команду под другую (отдел, организация) или на верхний уровень
+// (POST /team/parent)
+func (_ Unimplemented) PostTeamParent(w http.ResponseWriter, r *http.Request, params PostTeamParentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// SLA команды для открытых PR
+// (GET /team/sla)
+func (_ Unimplemented) GetTeamSla(w http.ResponseWriter, r *http.Request, params GetTeamSlaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать SLA команды
+// (POST /team/sla)
+func (_ Unimplemented) PostTeamSla(w http.ResponseWriter, r *http.Request, params PostTeamSlaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика команды и распределение ревью между участниками
+// (GET /team/stats)
+func (_ Unimplemented) GetTeamStats(w http.ResponseWriter, r *http.Request, params GetTeamStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поддерево команд с показателями, свёрнутыми по иерархии
+// (GET /team/tree)
+func (_ Unimplemented) GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Массовая деактивация пользователей команды с автоматическим переназначением ревьюверов
 // (PATCH /teams/{teamName}/deactivate-members)
-func (_ Unimplemented) PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request, teamName string) {
+func (_ Unimplemented) PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request, teamName string, params PatchTeamsTeamNameDeactivateMembersParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -113,9 +249,27 @@ func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Рейтинг ревьюверов по нагрузке за период
+// (GET /users/leaderboard)
+func (_ Unimplemented) GetUsersLeaderboard(w http.ResponseWriter, r *http.Request, params GetUsersLeaderboardParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настройки уведомлений пользователя о назначениях
+// (GET /users/notifications)
+func (_ Unimplemented) GetUsersNotifications(w http.ResponseWriter, r *http.Request, params GetUsersNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать настройки уведомлений пользователя
+// (POST /users/notifications)
+func (_ Unimplemented) PostUsersNotifications(w http.ResponseWriter, r *http.Request, params PostUsersNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Установить флаг активности пользователя
 // (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -134,11 +288,806 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetAdminExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventsStream(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthLive operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthLive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestStats operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestStatsParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTimeseries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTimeseriesParams
+
+	// ------------- Optional query parameter "granularity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "granularity", r.URL.Query(), &params.Granularity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "granularity", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTimeseries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamEscalation operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamEscalation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamEscalationParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamEscalation(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamEscalation operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamEscalation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamEscalationParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamEscalation(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamParent operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamParent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamParentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamParent(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamSla operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSla(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSlaParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetTeamSla(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -148,11 +1097,45 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// PostTeamSla operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSla(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSlaParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.PostTeamSla(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -162,25 +1145,47 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// GetTeamStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamStats(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamStatsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "include_subteams" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_subteams", r.URL.Query(), &params.IncludeSubteams)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_subteams", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.GetTeamStats(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -190,11 +1195,32 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetPullRequestStats operation middleware
-func (siw *ServerInterfaceWrapper) GetPullRequestStats(w http.ResponseWriter, r *http.Request) {
+// GetTeamTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamTreeParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPullRequestStats(w, r)
+		siw.Handler.GetTeamTree(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -204,11 +1230,73 @@ func (siw *ServerInterfaceWrapper) GetPullRequestStats(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PatchTeamsTeamNameDeactivateMembers operation middleware
+func (siw *ServerInterfaceWrapper) PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", chi.URLParam(r, "teamName"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teamName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTeamsTeamNameDeactivateMembersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PatchTeamsTeamNameDeactivateMembers(w, r, teamName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -218,31 +1306,39 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetUsersGetReviewParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "user_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetUsersGetReview(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -252,22 +1348,64 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PatchTeamsTeamNameDeactivateMembers operation middleware
-func (siw *ServerInterfaceWrapper) PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request) {
+// GetUsersLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersLeaderboard(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "teamName" -------------
-	var teamName string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamName", chi.URLParam(r, "teamName"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersLeaderboardParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teamName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchTeamsTeamNameDeactivateMembers(w, r, teamName)
+		siw.Handler.GetUsersLeaderboard(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -277,13 +1415,21 @@ func (siw *ServerInterfaceWrapper) PatchTeamsTeamNameDeactivateMembers(w http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// GetUsersNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersNotifications(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params GetUsersNotificationsParams
 
 	// ------------- Required query parameter "user_id" -------------
 
@@ -301,7 +1447,55 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.GetUsersNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersNotificationsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersNotifications(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -314,8 +1508,42 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 // PostUsersSetIsActive operation middleware
 func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetIsActiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostUsersSetIsActive(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -330,6 +1558,14 @@ func (siw *ServerInterfaceWrapper) GetUsersStats(w http.ResponseWriter, r *http.
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetUsersStatsParams
 
@@ -472,12 +1708,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export", wrapper.GetAdminExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/import", wrapper.PostAdminImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream", wrapper.GetEventsStream)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/live", wrapper.GetHealthLive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/ready", wrapper.GetHealthReady)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	})
@@ -487,18 +1741,51 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/pullRequest/stats", wrapper.GetPullRequestStats)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/timeseries", wrapper.GetStatsTimeseries)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/escalation", wrapper.GetTeamEscalation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/escalation", wrapper.PostTeamEscalation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/parent", wrapper.PostTeamParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/sla", wrapper.GetTeamSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/sla", wrapper.PostTeamSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/stats", wrapper.GetTeamStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/tree", wrapper.GetTeamTree)
+	})
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/teams/{teamName}/deactivate-members", wrapper.PatchTeamsTeamNameDeactivateMembers)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/leaderboard", wrapper.GetUsersLeaderboard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/notifications", wrapper.GetUsersNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/notifications", wrapper.PostUsersNotifications)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
 	})
@@ -512,50 +1799,200 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xa3W4bx/V/lcH8/0AcgBY/bDct75RYcXVhRaVkoKghCCPuSNqY3KV3hmoEg4AlpUlT",
-	"GVFzVwRNjCAvQMtizeiDeoUzb1ScmV3uLjm7pExLbnIjULuzM2fOx++c85t5Rut+s+V73JOCVp/RFgtY",
-	"k0se6P9WOWsusSb/U5sHu/jA4aIeuC3p+h6tUvgZLqAPp9CFM/UCLmAAPQJ9OFdHBE5hAOfQhQs4UYe0",
-	"QF384qmeqEA91uS0SiVnzXX9u0AD/rTtBtyhVRm0eYGK+jZvMlxU7rZwsJCB623RTqdAHwkeLDpZUv0L",
-	"TqAHF2of+upLI5/ah4F6TuASBlrUNzCAY/24B2fqKEO8tuDBuutcSbhO9FIrcCEI/KDGRcv3BMcH/AvW",
-	"bDXMT3yHP+q+g1Msfba6/ulnj5bu0wJtciHYFj4NuPDbQZ0Tz5dk0297jtZAK/BbPJAuF6mp0o/NxM8o",
-	"99pNWn1MVxfmH64v/HlxZXWFFuhyLfX74ULtwQKujXLMr6wsPlgK/13/ZH7p/uL9+dWF8K2Rcq0wuvmE",
-	"3DarxUp8bESLx8dz+Ruf87ocG292OD4MRV+RLHTe1O7ZztZ6wHdc/tfQmzf9oMkkrdLNhs8kmrndaLAN",
-	"tIaxaji3125u8MBsJ9jC9Ye7cT3Jt8w7v8U9+xvpS9awvRrZkxkXzjRczLrHdqNR40/bXEjLPoVwtzzu",
-	"pDebjonQkwlcQBfe4F/1NcYIXKhD9TeinkMPjtUL9S0cQ089x+ggt0pzc5UPMTQkbwqLSYeCsiBgu/g/",
-	"a8ttX4eMbXQ94ExyZ16mjOEwyW9LV2NAhkGS/oU6mmWGVrvRWA+MLrMETY0xWGAZJSSTbZGMr8+WF5Zo",
-	"gYaRNB4fI/YfFcW2cFKnwyULNptP8JuVbT+wOU+uxX4LyrLpBfPauC6aHONe/xy6/P8HfJNW6f8V4zRZ",
-	"DPG9iLM85BFWjMZCnNsmgmEyDUZCZIkdLjgmvCvWWV26O8nlNny/wZmHn0apzGYbfDedoHFCHH5TSKxs",
-	"kxlT9ZWlzdPdte4laYnJ+8pIPCHOrbeC9brf9qQ9SxgomzAoDO9Jw7I1krnpMSFti41LaUnUHSyeNn29",
-	"uCsReulyjdRCYCLzGqqa3JNkhQc7bp2TW6tcSLLKxJMC+ZQ1GqRSqtzDTLPDA2ESVnmuNFeK0ixrubRK",
-	"78yV5u5g3DO5rfVc3OasIbfx5xbXmkErMEx5iw6t0gdc/tGMwL2ZGkx/WCmVTHnkSW5UylqthlvXnxY/",
-	"F75O7HGNlzZvAsqiYo76T6gNwCyqSuflSCOuIGYzu/o70W42Gda21GyA1Ld5/QnhntPyXW0XybYEGjTc",
-	"4Bp+VWzFcF809tXS+8KinGVfyER6+MQMN97ChfzYd3an0FGinE1kEtouU0vyoK3gdrlUKluxu0rnHYcI",
-	"zoL6Nu0UMrV/MwlrxuRjj5J0E9EZc8ry1RTeCrKqv8e0XUFcu4OCvEu7xHncpO9OjqGMeHn5M1nVThUt",
-	"yzWi9mAAb+AEG0s05t3S3SuFcp486WbNsj78E45NJ1lMtrfQxbq6Z4rrX8Lm89BI94er2XS0J0z2aHFP",
-	"uFwjrkNYI+DM2SX8C1dIMWKLmfaJej6A/0CPqD11oL6BntpT+3CsDqCn9kcQCn6KLKL21QuyXCPQJ9A1",
-	"mkIV6T78a5wDTvFVsgXp62/gBAakYu9CoA9vRsiE4ezqOXQTWJjwJ2FBRJ3KpgbEh3r0DHiYHWZ5QTMR",
-	"vyYg09shT+lmkCfu3ihm/Nvl0u3K3dVypXrnbvXe7/7yzrAp7CluHp3gWAOUjpaBOtLsWJ9E4twwWqE8",
-	"o7A0GrsvdVz1dIhG0XsKXTgNhSa3oK+/PIdLGGi67ELt6+A9IjCASx2nXfUV9NXRh9PHYsCN90wdjrXo",
-	"gxki0m/Evhp6ZSXX53L8B+fK60NmDuRCaon3H9ZYebfvXXtBgXtoNVidO+sb6KHte/TdRfHI5Dkk2QCO",
-	"YQCvYTCelLp0IlUR0PRKa1OgB7zUs/fGGLo+hvCxOjTcNUY0yvde0KQPZ5jA7ST6CxvaXLEECmmYiHRN",
-	"ANUPZg14g7hzrmHoyNQOiEt7cAY9MmSwd1ijnVVODQfF5VSdeZ4vSYRJxPeIkYEs14wqPP8T5jmuE3ZU",
-	"abnUvi5gEPTVAVyGlKqGUSwO+6Y0Ql3liTZCs8fSeT4xNAQJXUq30vVIHuJ6RHLWjASV82H8jgj6Mtdo",
-	"r9QhnI2xw7aK7Dx/E6mjg+QpRsgGuEIfZEQgQ6RP5LYrQk2/uxIWfoCueq4O1N/jIDoxyW7IesMlhjMc",
-	"o1+TMJVZ4k8djWfN8aFhJYuF6gWc4mudJ7NAROuawAnKiEP0MFPr9szv0fOzKTOriDipLEokyQnrsbOm",
-	"jZFzlvLc7+ODk3Llo+ikpHJneDJSvlea2tLR8Y7Nxj/CK/WNLkV0xWU6Dfylz/yMTxXGg+AgNlcXXmvb",
-	"vIa+6Te0wi/UgfrWMqc60HCDE+faA+OxyBwnv7pZ5aw57zizVDRDxvpxilI1Rx/D8sRk6ZgZpfMNt85p",
-	"p5D/USX90cf+Bu2spbhZ2mK7TX1yPLU5V4dQ9Y7ZEBlS+u9bJRus/oSHh7RZZUsk6xSKmqZy+D5FRSQZ",
-	"EuiaFFyajYVInxvHqD7c9zVyEaO7e1teIoWnB0TtEY0DXT1DdIvhHPrkVqxA9Z3aL8IAXoUV4Zk6Mune",
-	"WgFBD35JtkBowRQihIicBcw4/gGXmuGOL2E8tusvHlJMX9JAf5wNz/9nIujqmGLJD/+AHpyizdL59Map",
-	"w+/z+UKM1Am5akoHzvFAUXwmQ2fpFB2ujcQkv504+GwxWd+25Ct8rKeLvO3+8POH4ddjfjt2LSf7XlCL",
-	"6VOaxLWgpaveClqbIY+GDpomsHIAPB4/tsuf4BILBhjAKRk2lCcIHURbuoveCH0NG19BX9tr2gsW9sM8",
-	"cY3sQJYCEvd84kOwR4IHgsR+5RDmYQ8lhq0Vd97umMzevWAvmlZpoohThwXNZmWU9b8KCPi3ju69cM92",
-	"B8LHmelolDhXeznMPJznNEHQg3MrQZ8BN+ibAjOeOQfOy3vabR4MR141/SXvAs6e/JJ8lVn+WumutZHk",
-	"OOXRwPR3VMZuAFluqrzF7YG0MFPxW0lgXK59YA53su5j3nhsvpye08pP08u1DxB64DUOz+XKpqJaotjS",
-	"QZKKLcHlopgfXqLJ7jP1pyuJ0TMkykRpt8kagk/vvm99PynTB/Pu51wDO94OLzKNq8BWvE4seidUF5M8",
-	"Wl+rmi53/pjoX74z5BdmhgzP/BWF3s+GoQk3Z8JPfQln0IXXJJEnL8ITuX7e/e+cQJvEqJkAC7m095m8",
-	"xi+elSuWi2blkvVi2Ucj3d3U/Vd8Fc6K+xZm7jeI+5lsoW2fSZIhjMgeWa4VElmgYM4gPrS5Zmf47FnU",
-	"MpnaC7vv8IEZnHiQ4ikTz8MbZJ21zn8DAAD//7DjWlwMMgAA",
+	"H4sIAAAAAAAC/+x9e3MUyZXvV8mouxEr3S1JrUZ4BhGOuBoQY3lAaIXGj6W5Tak7hWqnu7pdVQ3IWBEI",
+	"mWHmwo6M79yww7H22OuN2H97hAStV/MVsr7CfpIb52RWVmZVVj8kwTCMNsI7qLoemSczz+N3Xg+sSqPe",
+	"bHjUCwNr+oG1Sp0q9fGfs0vOHfhvlQYV322GbsOzpi32e7YbPYw2WCfaItFDthttRJt4oU1GFhYJ67AD",
+	"1iFsn3XZIWuzI7YTPR29SNhreI7tsh3Wjp5Hj6KNaIuwbTK3MnbNCSurhL2OHsKDHfaKHbJddoT/67CO",
+	"ZVv0vlNv1qg1bZWscyXLsq2gskrrDgwvXGvCD0Hou94da33dtuaqtN5shNQLF2mz5qzRanYat0O/RW/b",
+	"BMbPB9yNHrFtths9gqF22Xb0iHWjh9FzdoRjItEG60aPo4cwJ7jKjliXvWBdvJ3Ib1bWxj6hazZhbQJT",
+	"IGw7eoovPOAzIuwVUqXLtllXmwj1WnVr+qYFI7Nu2ZmJrdtW0/GdOg3FAinf/ISuGZbqT+wg+ip6AuPf",
+	"YbvsEMYRPYJRRI9gCNFG9Ih1xgn7JpkwHx+sRRfXNNog+MghYS9hOvv8nbC6hHX4bwf8r238K3oiFm6X",
+	"LC1dLXkwT/aKbQPloi9ZG0mcJWb0lO2pizASbfChvEDaAblwSyWUDsfi5R21YZhsJ3oYbbIXrMMO1XH9",
+	"98OvyVSxOF7y2F/i10dPyfn79/kKaWPZir7ie3O85Fm25QId+ZmwbMtz6rAiqbXWFrHu3L9KvTvhqjVd",
+	"PH/etuquF/89aZv26gru/uziwemz+VY8iDYFUQWV+EmJnkSP4jOSOYniGN7+n7dHxwn7f2KTq3fFKxs9",
+	"xq9ED9Wj12EHeED/++HXJW9qskgWFmcvXZ+/PLc0d32+fGVm7ursZTt7VnfFnscB8l+iLbbbn6KCCeik",
+	"7EO6hVattkh/1aJBOFf95xb1TUfgj2xH7PdO9FvWYfusLfb5wmI8ml/hs3IwzVatVvb5i8tu1bIt+MP1",
+	"gY3A2RxmjEvUqc87dZo3vL8j2fZZmx1Ez5Ar7AJVD4E3ahw0Z6whdepl/PdJRvlpQP3jkFDszmfsFZ7P",
+	"Nj900VbOYFsB9U9G0HV4NGg2vIAiB7zU8FZqbiWEf1caHjAF+KfTbNbcigMTmPjXAGbxIBEhDyzq+w2f",
+	"P1KF11+6Pn/l6tylJcu26jQInDtwsdLwKi3fp15I6o2quyLeh4MP/TUSrlIiNok17bVqtfV1dR7/4NMV",
+	"a9r6HxOJeJ3gvwYTs/D9RTEPXAB1wE2/sVyj9X+KBz7YOxf4U5xGGTHQZUdsH459wvvZruH42hr3J+yQ",
+	"ddlLuFuTiawTPYqeWeu2daXhL7vVKvVOtgBXri9+NHf58uy8tgJOpUKDgFSp59Kq9e6S968ooDpCmDwW",
+	"B6EtVAmUK9vwY5uwrtCC2tHnrBN9ZWuHHITtk2iTvWTtaIuMsB04SgRPeI061dFYtfrF2BL1HC8cm7uc",
+	"CDC2zV6zNtsR34XFa/Plhmti3UAagiwB/QtkCbD+Hfw4/mGt2ymdYpG2Alo92eLOXZ69tnB9aXb+0i/L",
+	"n8z+srw4++mN2cvaSqcEKrnnBMSp+dSprhEYALnnhqvEIVV3ZYXikYxP3ju7K9JTwnWFIxdtpLkmO8po",
+	"L5oO1mWHuDJeSH3Pqc0m9D32kswvzS7Oz1zVFsEV7ycB9e9Sn/CHbEuRhNPW1MpkpehcoB8uf1D9UeU8",
+	"fYeP5e/ZUbQZPRKHADS7LdAvv2Ad9i3IsIucp+3gufg2UYdBKeLrsw98b5ugGvmCtaPHcNLg+G7jMrbx",
+	"BUShDyglPq00vKoLo7jiuLWTnh6D3qWtmk+DRsuvUDwzXFDRqk18OgbHh7ghcbwqQZH1TrPQRC1NpBLa",
+	"XrEYOhSiq63ovcAmE/sxxQoPpCn6Gllhmx2wA7YrFC1gsfyUwVpGz0Zh8T71nFa42vDdX5902T6dn/l0",
+	"6SfXF+f+JbVe8AHqheJVRCpC7+7a/JkLlHyxIekMWgYXg6gx7uF5grPyKCZ79BTI/DOn5lZxuKfAy342",
+	"c3Xu8gwej9nFxeug1Fdp6Li1wJq++cBacWmtKujeENpnshp113PrrTrhCiapoeZJ3IBMWuu39GOGh5xU",
+	"GzQgXiMkddxzMwtzJGjSiq4efj/55R9UxU+eJWmQ88WMNmPzHRSZzxWL4HOEapLpoX5+ueXjcG+EDoeX",
+	"Mue+Da8SuNAuO4gVUdhgB+rOEdx5F8zfbRI9wQfb0eNxUqf+HVoO3TpFS591+fgAc+CbFNgEAFM7rMtv",
+	"tsmK6wdh2ad3XXpPPlry8NmFxfKlxdmZpdnL/BGhsm0LtGdx9mdzsz+fXSzP3Lgx9/H87GVuPYN9za3f",
+	"BBNiL1HlPkLrbpMP6tvoKQgZtsfBsm/ZLntF8JZX8P8leLJnlzxtFmD678oRdaMtth2TK9rSPsXa8kQi",
+	"foJ6xUuJH3ADk5v8KOoObbHegDKAav8UVViBgwB88A3iDZVGywvJj0mBiMVox4caTX4UmihiHyEvlzsG",
+	"3sRt/6bfaFI/dLnx5ty9U15ttDiWtdLw605oTVvVRmu5Ri1p+3mt+jL1gW3g9w2b6BvWBdruS6kNGhQQ",
+	"F9eFz4lDbslbQdm5w19bp1XX8YYaSfNCYcj7Lwxx/7pqH98U007wwMbyv9JKCK9FDnEJ2WCCHi7Nzlwr",
+	"z/5i7sbSDcu2Fha1f1+bXfwYBdL89SW5gfHP8qWZ+cvASGfFr1eufzoPPxn4q2IvK5pkSuSpNl2uCWDS",
+	"brLIp5ip5IW9BYI6eIOeBMx7pdHyqgOxan3Hyk/plytiCfpyc1yrdUVAPbDckNaDfg9fASGGb7DWJXUc",
+	"33fWrHVlkg+yhFNnmDk4fzOajSbM9RdjAmhDm1PKBNa2THCSvnurVFmI7DZO3c9JbNrtChEyKyCkfJY1",
+	"IB96Rth+zKC+ipHhdhroHgHei5LoFUF7+QlAJtLqjlG5WJk8RKH40ESCXkuSmi4feC/62NZPqFMLVy/F",
+	"2yI7e+Vr8lxwZYFWyXmb0PtNWgnh36axBqETtgKVhTQ+s2xrxXFrJi+EPn7xcP6wF2mz4YemM6N6nZwq",
+	"t5ic2oJ2V69DkSZLRqOpOqGz7ATUJnX3DtdDAltYt5ZhwKdBCFudmIkqc3Wgh4pb6nThBzWzSJ+5XlUd",
+	"GaBClo2IqmVriLWRf9Zcj5oYAO7gLuhvJPota7M9OBjcmbLPuhI12iNCN+nAf2M8X7OfjKLVp05agcch",
+	"k9YHZJnWGt6dgIQNhLhI01mrI9X6URrnIihiWwJOxu/0ondL7LTULvSpE1KV5MroW15l1fHu5P7crOY9",
+	"m+aB4ivJM+rL80edf3b47hlcgKR2nUGIVP21st/ylNksNxo16njWur6/Bv4WUhy+RJ360A/BLhnyoRTR",
+	"4wnFA4jfmZ6NrZDTtBRX0VW13HD86qwXGh0kf5Za9it+lECIbEfPoq9QTD4Usia2JDqAOo0T9tf4LvyV",
+	"3xp9gXp6V3FMLyyCdnuIj77kHuRt7VUI278WxzjHJfMMjRuutG+j4o4HmBsrmfF22eFF0mhST5hIAVpW",
+	"+CrwInzJLTTgD/vRQzBo0ARIXhPbNRI00OfO2kZzIAjcOx7ygLJPK9S9S6s5xNZMJcGItC8MYH8ZGRaY",
+	"JGHL9xwfNMREZ8/wTW6wgrWHHn7481AYPwZrLtrihhtan2JsypJLB/ihQsOLBJxKyj5gHYBajoD7WrbB",
+	"ioDbHfin8KllrBA3KDuV0L1LzYdcXW8zx/Md7zPhowPURPXQpZg+rGTZueeslX0nNEie+B5axbvIBBlJ",
+	"X/onIsZShpNfoyGtjmZpgoBTF5eiq21AdEMDjUGBPULRdcC6QlJliVd37sspyekVBiBqathGLXtfwZNR",
+	"d3yV6zmFTQouc5hctKHNKL3HcyRuimbmlUzcxiZlI/bT5v2W82CKAeNusRWnr3zU1tzWybY0DT+1L3OO",
+	"qG3mHtnlMW5PE9u/RmGFrzacqkFHG+4gpfbDX1Jsc2HRJuwFsHGOwLSRTWNYAGe7Ke5sXHdtQQ3uX8XF",
+	"mPoGa0vYDBG3J6qCh+wsMZO4cALn8Z5yCLlYwfAA+Bd/Z7RBXK9Sa1VpOWgtxyL4DW21ofaYtjympZ9v",
+	"XHK8KiDU9Jqw7Kt0xWnVQmvamr22sPRLKwOe/hfoykRgmG2wOVUCGfSBI44WtqMvYr4kmNm27hPetcV1",
+	"FBrCm4zMrA3i4t8Q+ELQjyOwZAQ+yXayruXMraPTJQ+nE2+AWD1A9z5J4EnD+EfiaL04qi+LYHb47kV+",
+	"hi8U++QhB1JHbaKiTskm5MgCH8NU4ULJ024TTD96InQXTkgRaQTPcNUitpPi5VLfkbWQbOv+GDwxdtfB",
+	"3QOuA3UbzNabIfAO5dIVtApxt4QS/b+06ngerWXPIK07bo1PUdhL7JAPvk0AfuFxWTzOEIm4ISyzXbZv",
+	"k8qqE/KHt6PH6LLcQh1sj9yjy6uNxmccD38EptuKW+M4+E9vXJ8fk+9B1XBbGnkxqBGE1UYrVOiFA7Vs",
+	"Cz4JgtKtUaNBqc56wacrgZHvtNl+nqR7RlBdPuLBoEQQI7OFTKrpOGHP+b40BBFGjzMEJKo3isPRBE8C",
+	"VwyfljxOd64pgD/jBeqN8YlKOX1HPJj72ngiccoVvu7BqEmvjX8c2Fgz7SiDxcaXKkv23yXbiUeQJHNl",
+	"bRIvcGZF3aAsuZwJYjeuoSF+kh1kFsCyDVLyVy2XhomG3Ysg/wy3/gTv7CkycqWCXAJtmibev7AoXVVZ",
+	"ZwUXGjQF46/UGnhW+qqKGadTv3nr3jOEFWNX1/EezdEGQSKafwkboVMbAOHg94k3yY8ZCSw8kCa9SIZm",
+	"CFYF4NMh321k8col8sGHhQ9sLiS0IHIuimYqFdoMSa6T1FadBgZfAQflRUyl5iZwvSB0vAo8MAE/Ttyh",
+	"YV/vQYInThWmbCt0wxp+sRGSK+K9gjgt35tu+mPx7ppGCHzaa4RlPoCMG+KY/gajkoUfOy1PREKovq6I",
+	"HjC0YRty4hme4hfSe+nTxXmCWhIoPmbyllqFwrkKUBL/RfvCjvhrPBRbxXurZgtCCU82sJPYKtF4ij4L",
+	"wb+ygpGLOYNo3CYjhfHx4qhlJ6uZQ7Jk0ZLICNPdArucCXXXpRPSMeRDuXxPdYb4d072hnRE9vSDPvfk",
+	"mrZZjP/6AroohWe0L86fDQ7PftjWok3kTjGseZ99c2PViP/2XrH3gVgmuihagCEpSUJwPPYAVF5hAr0Q",
+	"Zi+cEkDh2KEaN9E1wnUCaeOyJja6DpLkkIskCB0/5MrtK4zspF6VGzyJF1Gm/XSjJ4g76ctIhUtHukYK",
+	"H04XCnCbE4bUh3n975GbhclbNwtjF279pnizMHbu1uj0zcLYeX7pH3LceX6ov7dYPIX3wlkt/7ph9CP9",
+	"F0bjYEYT2xPhKdEGmZuZn1HFrjXbAgJMXGsElcY9q39yTMrB5nNLpWqpozFtFci6MLlJQRMbXNbBWzgM",
+	"ZGKbTccH1T8Un+qHuSB7RsD4EFPCAEshI0KX2WUHqNc8hDBTtHxeiRDxrdGLHIBRFR5li3F9hI+Fg1vV",
+	"615tLZeTajjRMORPHrQlIfNIn+dt4xBMWVkHUzROzx9BkpSbeb8jtNP0B0PdwPDeli7O7bEMMNZl25ad",
+	"95V8fM/koDC92wCrsEPWiTMFMuM1jCW1RjHt7DSlFcpoRBwADIP1nA0qTo2HNxp2ugCaok1MM8QIasSq",
+	"Taa7xpIzUJfkuSK4LMbF2mwfXsu2heYjDNodruAhs9+eRqQJiS5C4PYJR8KizZI3oqR5siP1jehPE7kb",
+	"yYYY5YkwceLjfmoM7DC7om12qE0nemqA3HhaaBIviKDOvvFlJQ9JtpMEQeLLOtFj7TO2AGm/xcm+BHgn",
+	"+kKESQr/0uNxAts/E5T3JfdecSHFxxJ9Aa5MblF12etoU0xAy+BLSTG5OSQvzIYfUKda7gX19vJH5DKi",
+	"vO0qePawsL0yvp6M8cTAdDKOvBnMCwNPH3/jnjeIyEqc6CkRlVUVYmB+GIk4L2zKtDzs7VKSQMKgo+8h",
+	"foAOtoQc5CTyiHnj6kyOCxdg0Y5EyTSOGz3OSojU8ca06QMRYYw3ICi7T0aC0KnRccynuevURrmG+Rqf",
+	"lQE0Ru4PTnru7+dIOSoJPq27XrXsrITU53gZoO9KNh64PjnUrmdqdzBfgkOXbZ4LPUriqGAlN9cwFLvk",
+	"JQFAPOd4RwQgdDBEmqAmDUyJDzH2qamDLHD2Jj2xCkhvBEp7IpB/TzNX7gkHrr/NusIZcOPqjG1EezPe",
+	"+CEAXyN6aZqxObhX9SYbvMfpteWae6XWCty79Fr8LNfmhnz5sZiqcUw58+2LpuLxM+OpGeegMTot41bk",
+	"brCU1rCN0TEbiBOCHQIK9gYcBH66u0JfPsK4945xPWsNp1q+43quWZeP/i36LWwU3CCYh0TY13AYRABC",
+	"inUobvtoQ8SKdLJ6jEkrnCby2OhBRTxgn1sR0SYc0FjkQ1EGMhl7l7qYHtURNEjFRLRlsASoME9EiQTQ",
+	"gvbZLj+Tg8dGZDacortnzPMNg3sb9SFY0GgT4nJ4LiTkxfaiqIpt9RImigPfZL0NE9EWOwWUQ9MLt8tG",
+	"fGRxOpXF6AiEyBuGtVpYJCPyO+NN6peb/mh/939WX/Gq1A9CZ2WFVgc0jTRem8QnmKYi+HosCTL0GXC9",
+	"VLg0s2A92JUhyiAd4JeYQckxNy5lilRGlubWaUB9lwYLDdeYjvI7OLYokCAO4KkI3EkJY1uLqEVlvMP2",
+	"4po4HFwakZl0B9FWLOFR8ifXn8UGA6Tp/FH/RvQ0duCrnCdOcy153G+SzdXBzxxFm/BZYJjjwOsOeOy7",
+	"OWiJqywi0SbGyASvlipUtGmbQ+NixpXZXtGmSU0Q+TrcF5YXoffvnOdyx6eM0OOfzMTiQQSOHmIpjDy2",
+	"nVk4tpsJQoNJx5bZCcLzmn5Q7uWlg9/BXM/73aeJRzoYNA4tTXIZrwGbAPhPjwCPXZkaAXsx2WIxctUz",
+	"QC0Gw81zkSCm5i+wBsUIFVJpdDV8Pk0327S/TIwAaqYMbWO+jbi7IYKh8uaVo7MJX1C56ZdlLl4PkK7X",
+	"TYLn9rvtOL7+9CBNH8uO0piYFNBKy3fDtRsgozgNZpruJ3RtphWu9tRYnyB32Mcgnbhk1kXkJ8/Z1ySO",
+	"lmAdwSr1kgPcmAlWneL5H42TpIoX8ms8iDvowuDGWLbOB1cPt/UcjkMtKXacsD9oeV5dtq8WFlFjbJXC",
+	"bezIFpafCEXn2ksnfrvMJsPorREh3Z6wXTJVODfaowDUL8ZmFuZEMa1Y9COlYRd8RB2f+jHNl/GvKzFj",
+	"+OnPlzLheD/9+ZIIid/h8VYyg3YvXcCMvSI//fknN8aS9JeU+TdOLtUctx5Mk9tBa/m2TW7T+034j9+o",
+	"0dslb8Sp1l2P/CYp1EJ+Q7jGQX4DlnG13PBqa6M2uQ133Cbpui5gkt8OkfC3RRpyZkXJSJIpzHcHCE1h",
+	"eoHo/zPbhbkgE9+IHsVzzb4InpsqTPGlQN0LWRTSNKH9ahg2eZK466008PiJ+IWFRbIo9CUyI/kmuUH9",
+	"u26FkpElGoRkyQk+s8kVp1YjxULxPEiCu9QP+OJMjhfGCzGO7jRda9o6N14YP8cdVKt4yiaQqBP0fpwA",
+	"c4eGOZqrKNmXDQrhe18kMHJdhEv4Xb2CBMcCnrOv7dhm0jKuUTvBW2LL6QXPN+bm5D7sEPa3FPSsac8l",
+	"T8GSMdr3NVqUj2LYHCoESlMv2uT1LkTZJbHs6UpNe2pu9ysRLan4hW4LCrqYInN7nOjhNTwzWhimJU8f",
+	"AUfJv0XgZ1up18fvlkMQ9iIICIR/56rWtPUxDWfgw7N85fSKhTcfGMuCCRmv1kVI4m0hdKemBCjGf1eC",
+	"uyb/861UgbBiodCjvMT9Ma+aKTFhPShhclnJmi6h3CxZdikRoHh52al8RuEWuxRbFyWYXykWRHhXaxJv",
+	"iMUwXpupuRWKl6UYLnGVcP3WeslTv62aMfhEyp3Ob/LHJrM/ys9dce/jr9LHrg6MO9vxCjj28ZqM98DL",
+	"cHrHJgtjxamlyeL0uanp8z/6l5K1XvK01cqK5pDeDydgiTTCwsxsSUhbkMqO6WNLitipmdqZydlyQjaf",
+	"hR3LfSe0hWx3QjsbclHyYAC2WEC7NWnjitiolPP/K3nq5/ASUNm+4t6H+4FUtokwtt2HLoYaRdq5awNX",
+	"nCoU8kxlubMn0hVU8LnJ/s9pBW7woXP9H0qKvK3b1vlBhqeXqkJNqlWvO/6aYc6C6abcWlj+0+QWYx3E",
+	"sBcW+R1GFypaHs4d4DgWciPgFPfHQGDjNYdfg3FpbBI13kZgkjT/mQQjYhW3pGJJUt+SC5lLnNWMLa01",
+	"6Thh/ykCrmUZCnlzJ6dMJk8xfxJPF5XGWPTsEZ5zzo54bhMHrKGy6Z90cLyTY6yLqPxWM6CAMBgROQX4",
+	"lyatGjwuchIAz0NJqcTFQCHWTR6C8yXbVUCFL9E8XlgseXGxLT2gRqbXJ+kk4HyUtUckVg+Q5z7e8NhA",
+	"C+nH4dhLLL7gC7YpbwCUwy+UJTFXq8UfpwoXUNvt8DJgXK3c5/Hot0Wy6Y+xqHCcLKlo9K8FcgtbFPd8",
+	"Jy4+tsEzJE2ydKERcGE6VzcLU9MhTG6ZSJUHXrfN4jdJlDXI3xWnFtAsYM7lLPLHjxrVtQFF7KASoycH",
+	"1UuHrg8l74crlqQlYZu49x+TMjrZMs8jsvqYsvbwgwhXFmQHXqXU3DYUN84bpnhqwlDten393RckU4UL",
+	"b2+lYi6cZiOynJWq8x9mcz7EWsK/0dQVucQiES7LV2RhBFF3COZbLA4gOE3VN09F6v4h7U7SY0jMwgLD",
+	"SFDYcgsoZSYMIWbpXb5goS+CEcwGHaQMvUSAYVdP197NqR+/jzlGt93qNOER3bPwoXG3in9RNNfhiv4z",
+	"sJXkBqgTov2OuVPid5Ct3+ipbhxXiGGEOFMJLiTOwRhaf4oRArDHbl91gnAM3z82d/m2IqI3E6+PsD+T",
+	"8JsvlbJdKkGiLZPE+JiG+IHgBqdzRmKkyP0fKiCsvd0U/QBbQJite8NXp+7B/IcYl3RF5aaVob3eTqrZ",
+	"d4wpmH0rVA8xXAjRlyFdoipAXANOrd2eQAfKhPIAMW239BxPf5MXZSseg7HkACZmGRyeqWLJEwclU+Cu",
+	"5PET8qBkof04VQR7GI4QmIjZ23uZqoXCZL49mm9jx+abfKCoGqtl5yTWalZafBNDR+mSEYlUPRWx9R1U",
+	"8kTl/iGehn0JE+p77ftkS+atVOzXE6GdGZ8jO8xwBJ4+cwOrQ43dACnAWalqTPIrRjFnWxLQlR5nEZCN",
+	"+K+Qg6tYtEoRgBkGzstaWSfUa3XHjZLIIU891rYy6OEpJ0hmF8VIrxsQPpk1zVViTd+8pS4Rnw6prNLK",
+	"Z5Aj0QSvORnh6fIvwZREMLZLBGkmau5dqtJc0EMlH97Tn4ZX45oS7yQdUavoRp+jmbyRpL4LXaAnUWFm",
+	"Hg0C4TrXXwRRSdt2XGAGdFCAGOLi1kl/DmGTJDZ4H6JjOfb+VF/E296gWabVlTMR9v8KprCdeMw6HLqJ",
+	"ngnMgFet5Tzo3Nsb2Z+B9i/i4aE/MHmNCAbHWIJ2tKUtElfZk6XDqhHtnnsE1sFNNgm4HTEWbgOtntfA",
+	"DyHqIXqMgRFtHvHxUqBMYmts8Jh1aQxBgIAeo3/EM6O435/t9t5IzSTEZ4KLbhVxy4IfSkjQJX77SSGQ",
+	"gWELQ+FpJevOak1ahkS7WMMx5rlNWzPVKgmo41dWrXU7l8doyX19AtEbXtlrlCtxTYr+hQX0wibmZME+",
+	"3zSmDg6TSTRsUuAtIzPtBwNNDre4TT8vK/em1SpattU6Z91SR3XyPZDkV/K0yvUem6Lp91tbLXxuEPnD",
+	"y8rJgAAdhopbv/XCnfCedfsHBVlNfW91/9/FFvFEqi6QrETE9kSfp6eDw3PiBAmVyBWxtIkQgk3GG5+k",
+	"fQIiTu6uU2vl9L1IClcnVZwXFolbla1hxBdxul7C2/QhiP4FppyxASouZVO4eg06VUY7GbfXINyzSXzY",
+	"8BWKQROScRPXw3gQmMq72h+g91IKnAVLx6ak0o9VqnDPkUgCTOVeiyAGQ9rgO4Cf/i1mlbI2VkfCTKJI",
+	"iRL1ZZoYRp0Wc2KoZT6K4sNL4Le2olEpjH4ISzSrgAktPk+ZVz7zMR3e92TozTdkbMYPQUhrapGfo+mk",
+	"j+FxJfWZgH3D7DEjSU8N4xIosqzLF21kUS3puTKGRJyYgWShLJWZYMTNwMbcNbz7FNzZ/Z4QvVVPZPbl",
+	"M49erGBIs6qPiXQ8E+gtcdekvI8RgD81/iuKzrx9M4mna3HEA/rucH+fbKVyZjb98Li6sI56E0Ettz81",
+	"OYD2augd+N0rvt/w3nuyJOvCYux15ycAA11M3cURw9Qbnm6NvgFFNs7f6RG994c4+zwudqxX89jLFl5O",
+	"V/LQSteaEubTFURkUZG48adJXu9d7JFFJnIBBq37mxrxCK8MlFTnGLWJiNbjmZoy6y5lruVFoinLtRhT",
+	"/Hsiwhs1pfoIl2XHkurae95BcLYv0KpO4LvXKSDjo3X+jVtstiUwl2p5GTha67x1eipE6uU9Sjh22XYu",
+	"97ho6JoHLEDNwkoqaMjaFwZtP8nfHEnDMFgKe7RvDuWAdij7pldeaCoYEjvvnelJ77eeJJvt9igVnaNH",
+	"DYEyJxnSOsTLvwkqh1JmRi21JfX1XpCzvCmBbiuO5zVCWeKGNDyeOl6FkmyDQc+a2zW3gtmbBpZhoOGM",
+	"knadwRjyFg1qcxxkNBgzUttzElrPSbU3o0godHlv3VheYG+wVTcQlH6HOyRDrabN6IuEAe7EHavjBVc0",
+	"uB5NE6Kt77uxkJ2YQL8x8hrqjKAtcZQrC0WZmrgbPr+N4+MirDIdeXraBkUQZ78PgI3zTPlh4mzfYGBt",
+	"H+XwQYZp28TUYviIV3ppy6IuYmwveAqt0oo9Z3QrfqOuDcxY9fm44zvR0MLG8AM7sd8i1TtgcvzDnGYA",
+	"Skfkwvg52fF48nwh3aa4oDUhLoxPak2GL4x/kOkWoLy7WBifSl5e/CD98h+Nn9def76Yev/kVGH8Q6Wp",
+	"AL6DdxEonpPF/ibPFwZm2bLEkoG9muqAaSkJ3+JRgoSEMyXvTcq5P/UOHGDt0xIiusODZ64g9xeFFgU3",
+	"2OT11VK7I9rkuMbC4ollQ9bpgbJhIpRFoPJTWeLeqh2tGhLPDoiLyCozgym04wK5su5ikvYS11fSm7Nj",
+	"lwxRzm3Eb9RqraZad1Ko5Gp0Q5ftlbzbTrNJ+O23s+UI9FwGUedHKz8QbSk96JUkzrh9dFwrEj68J9v1",
+	"SKEFhmtsGSsglyjap4+3zQ5zMl2QYSTluAarNXDHd7xWzcGASXPBgaqzppQb4H/doxSifOsND2IZBxBd",
+	"QhHalqUzQWClemBelLXvDnHDP5HV7xCag6phFwrxjtnj4QMovoYXuYNIW/Z1MsrYZtIHzAuSEbYtc59k",
+	"xOdo/8mAyiNCGYYVzObhvzG9iP012pItRM3m7FcXeRyLNDr1EpREL1A3WJ5R71GeUAm5aa6bxiW9Unhs",
+	"clIvNDY5lSksVjQV8QIFQBTtAi/gj8YKk2OF84jwmr6LFdS0Dxf07xYyny2YPlvIfnWyiGBxQtfBKhun",
+	"yvplKxBmxeF/8B7xiMJFW1wmckYGOis6PeIgaTgIfKBnGspb1FCGhaSip6ejwfxeKTcoyjY85DzFUIgx",
+	"0QwgL0kEyMWlJQ7lJDh/2Yo+B5GoaDZcbz6BSgM3TTjVau/wDSjlO1OtfqcR+LK+7E2t0B6vp6g4eCbV",
+	"2nfTvOQOL3/Q46Gi/tBHjWUcbMLJpy3Zon5gs2ZJYm+nHK8e13T/rkkikjR7RmXEYx2AUIM4HvQzrgEE",
+	"7XfJzTD4YqaB0qXZmWum6OdEgc5EQNvfC4bcP4BY7Ta8kbSXTBrDas9gHZZdDC34LQcGRIz2Wy9jMUQk",
+	"yLsUx6yBjZskt+YSFFuSDz6PHk3oVXz4AubWkFCjPpbiXg09xZUimajWfCYPkk21qRlWSsHj806dnlak",
+	"cqYpitWsOSEYNVa6GwrnzUaeOoyUUSafl2wqA0gM4SMXZaVB1VGRKWSUDPNMj30fkLY+uyJbB17v3iSQ",
+	"iaMeGTbZNleaz2YwdpCjvdp9470ka9In+jkvhcZnJ7e+ei4Bokod4gx90O6PnmqVYNrjxuCpE7Kn01Si",
+	"T5819e0H1SdQKt0dqs/tp9DC7s3FPr0phq202FZz9M4Y8NtgwHYKkjZySqG+Ysu5N4cs/EEpr53mav3Y",
+	"90m0sD7ZYvC+46SJnbbe9c6Yw8MDBOnS1uzb6P/wKj3ZZTzL/zpzjqpxNQNYcKelc0mOIHrO5gfdc6ef",
+	"0oMww0VjdLyLdWpkRT/NWRmXInksy6olzXSjLeyOoM5f98okcVa7CtbKdkQhR6QX22XfRlslTwWLd0Rb",
+	"uegrHrwn+odFXwl/FtSCvXR9/srVuUtLUHyfV06JniYsOQ5j0lYHw3mf8yZ9+6K4H49uygQIq40/t/JC",
+	"8mEhF+LWv9+dRqm1mbSod8f1KEUNbGjlMdWx8v3QBE+AhqYHPVhodooVaacg4UVnsuDtuqHS7XxPpfKG",
+	"CT6OOVMGOyYilnmZknrjLq0S7HZG3DCgtRXSwH8R0UftHcaUkyDTblyJlzsADjBUmaDyu88OTjukVcoQ",
+	"XeQKiRFHrGJU0iCN3aXJwDuUSSnX4floovYXhGScRHEPak4/xf1GzfnOFXe19ytXvM0dVgvmnqkfFE9B",
+	"D4dGwaaCh1dn0hr4Gff8/mvS2WXt0Qn69BFLszZ3nKN4mqqc+dBNfWg+dcWpoRW8vL7J8vAX7He3h/Lx",
+	"VEzjCN8FBLIHv1Pxxuh5nKt1xvG+39iBCh4apNpJtIxUyoyh1GC/9rCY126ogwUmPs8t45ED2C4FXsb2",
+	"RfZMj/auGHzaTfU3ThxS2OU+7jggsIUUdiICsnv2/05yd/Sm3R2SaQ6O7ZVTQd6HfMWjx8Jr3jY35e+y",
+	"bWhG8DeS7niMXWcwfDp6LsaSZPefrFe6qsim3GajOWHZSc/3E+qU2bjc30tXm8C+ZLs+ddi7GbF+pHaZ",
+	"UuGj6HFOfK6hqfSwzXFOpA2nPx9/SmlTjzk/PfHuRpN6IsMosKbPmWV1X4Tb7vvm4gBvHgRwF3PUX14Y",
+	"4OXn9JdfcvxGDfWgTJf3TCbWhf6ZWJPjk0qyVO9ErOL4eT1R6sPxqV6ZWJNT40X58guZPCzt3VOF1Ms/",
+	"mBw/pyRhXYhTsJQMrGJOy/r8JdNaxd/sUQ/qTZdtuDWc7TZU9tiZOff+KTf911nUy2hD1GDSv0/mbCcC",
+	"l9cQeMkdKm/HmxL6lObqT417Hg/FSo+lI7ozcXeGIX3YJsgJ8OkhRb8hbYu/JZa5u5Jg4D3ppvyUffw2",
+	"+brDElCiX0L1n1BjTPKV2E4yoPwUo2MkB51YlAv5ffMBrCLej9KuLCW3KsUnlb7kXLagMNT+SCQj7v/l",
+	"vh8oKh84p3/gvPqBYvoDILl6+XaUj9/KkydCEJ3qqNJOd31UuV88p3xxatgv9glvDwZPNAIlV9R3SmUY",
+	"ZQGEYDB/z9fqWdRO4Zloe198/iqD0606zLp9jZ3O27LROOYOid4d29Fz9F5vogF6KDOOOqIyHzDpzunL",
+	"tGDiQSgsuvWJKsXjCFUW5CEEdy/UdsuioXAZxxCbhJfl49fE02+x9FzWEP0j71VrrNQBPex1mTMfo4Aq",
+	"uDekCDomniuMJL2Gaw9eltyfzWcXDS2h2ZYMkhWAOez2JLMtKZQvOWI/R34vRijH9PZ99dJ9qhDV+jSg",
+	"fkCSDV0ljgfFoAJZI4pWj9sSKqdlc4rAShUEUPEWFlUfv1ZRKB0tdlZM7b0RKz+gErT/jkbPhjgUZn4j",
+	"AOW8Jrbpcq49ejeww9zjhOCvuXXgMdFzYG4BRNcuorbZy1WPfOdjeeewAhAeP8VWDDqydvPBGwaDUjDl",
+	"gKXXB1fL1ZJeqw3fUABAGcGDPr6/+MY0ADmQMq/K2YXFfxR97IwZ7Ftn6v0bDjQaopTlm2j08I+Yc9Wn",
+	"4fGgdRlj9oR85CTKPedZNdQPlhuOX80vgvR7BItMuFWqWrVIwTJjTWa+3hm1tVo0UH/oEbh4bBJt8g/o",
+	"FQbVapasi7602CkZ17ERVWySAN0OO8wQWDSQhVm8RFDrCMtqb2Z60KLfSalKpyU3s/Y4YX8jPB7hx5Xg",
+	"LhGuNtndvORFv+V96+EVGNr9KIbrXsZurgNeVpHDkjmwGq74VWW9BiqVdDq1dP6MVIOklO7w9Y/OnVL9",
+	"o4FLDqLqBZF+n59a7SNtM+1p++EUaxRmZgLsp0bBOmEdAnhW4hKONvEMbMfDtEnY8j3Hb7Q8vFvG6PhO",
+	"SJPHcsrajEAtH9lE7QicxKMXoaQXEI+IcHiY7gZP4U9eKMRkycuhQ9DwQ6iqba7TJSeoVOtSr8GcgVBy",
+	"apZtaTPLKeRl3F18CcwDQXmTjEH8WQnumj5w8jJOSlGksk8r1L2L9ZcmbfQrJtOVXkKo9djfN+w73md4",
+	"pCWNnHvOGicU9zUn1i3+pBWCSgg/PfnhMR3NwxdtUljarBdizEAWSsDm97AaKh1xurYYkB2PxJbjtiXB",
+	"7MwUbZV2tonotmmN7BT97CyhS96k3SraHzWWbUE1G1dr8kP7nA3rWJy0i3ZhfPI4He3NwALXLUX9qv3Y",
+	"C7aHEuwIgpbPVMz3AUH+q7qoOS0IX7NuOoBpl4thVRRmFEn75BWouELpNUJ3RVA66GsIz2t3f7fGcGXV",
+	"8Txaw4nTuuPWgP+vOiHQhP8N0UHL/0s8Ml5BXUUNeRehL79quTRUYlg9mHHhw+lCwVIK3RX536AJlH/d",
+	"8Kg1bc22wAaeuNYIKo171nqK1w4cSaESdcGnK0FuufMNUd10j9e/20QZz4uexx71vTPW8T5Zp4Ouei5Y",
+	"QdL9XoUpFT3O8JT+2NngpT+OsuNOl/94HW3y5m5sj8RnmaupooGTMk3eWMKOa4YoJ5Y/wS8jovgY/ke4",
+	"6YvRm8YkzlPgZqeZAXA8XvY2GJcO8yXjHFBZVEl8iT+c0Rdtq+W5v2rROf5C4VAS01YssZgwGUdWig69",
+	"xvPPcOtP8E4dWxwmyyBBGiU9vuvMgmPLkLMSJ++1/NAqlxydVJgcQ2QkmmZAw7lgRtjDvYqe4qtvKHd/",
+	"l5w5Gyk9KK9UnnyQiVY/Bd6TvP+tNJWDD5sJcizcoU84RL8jBntkQPf+X5Saic/jFpC5wP6Z5/4956k/",
+	"IA/+3zO1Xp5B6wpoZvoivyj26TP+fp2dOMM/VvbSKUMKPoXQonLTL2upJ/4d/aKsiq9d/iCVezMwAACT",
+	"GDKV4sw1/QN0Ted23TEa/iOGLl7Y3EvikDbP1xw9NTc1DJ9WWtjnBQ7vTNP9hK7NtECzuXkL/DwfUcen",
+	"vrxyS375Qez14XE867a8wIekXNC6CinXf0KdWriqXpm9KwrHyyvimCUXZnBy67fW//8AC0whX5P5AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+const (
+	// Сколько событий читаем из БД за раз при догоне по Last-Event-ID
+	replayBatchSize = 500
+	// Комментарий-пинг, чтобы прокси не рвали простаивающее соединение
+	heartbeatInterval = 15 * time.Second
+)
+
+// eventPayload — data SSE-события, схема Event в api/openapi.yml
+type eventPayload struct {
+	ID            int64            `json:"id"`
+	Type          entity.EventType `json:"type"`
+	PullRequestID string           `json:"pull_request_id"`
+	AuthorID      string           `json:"author_id"`
+	TeamName      string           `json:"team_name"`
+	ReviewerID    string           `json:"reviewer_id,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+// GET /events/stream
+func (h *Handlers) GetEventsStream(w http.ResponseWriter, r *http.Request, params gen.GetEventsStreamParams) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, r, apierror.Internal(errors.New("streaming is not supported")))
+		return
+	}
+
+	var lastID int64
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil || id < 0 {
+			writeError(w, r, apierror.Validation("invalid Last-Event-ID",
+				gen.FieldError{Field: "Last-Event-ID", Message: "must be a non-negative integer"}))
+			return
+		}
+		lastID = id
+	}
+
+	// Шина общая для всех арендаторов, журнал в БД — нет
+	filter := entity.EventFilter{TenantID: tenant.ID(r.Context())}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+
+	// Подписываемся до чтения истории, чтобы не потерять события между replay и live
+	live, cancel := h.service.SubscribeEvents(filter)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	ctx := r.Context()
+
+	// Догоняем пропущенное из БД
+	for {
+		batch, err := h.service.ListEvents(ctx, lastID, filter, replayBatchSize)
+		if err != nil {
+			return
+		}
+		for _, e := range batch {
+			if err := writeSSEEvent(w, e); err != nil {
+				return
+			}
+			lastID = e.ID
+		}
+		if len(batch) < replayBatchSize {
+			break
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-live:
+			if !ok {
+				// Шина закрыта (shutdown) или клиент отстал — он переподключится с Last-Event-ID
+				return
+			}
+			if e.ID <= lastID {
+				// Уже отдано при догоне
+				continue
+			}
+			if err := writeSSEEvent(w, e); err != nil {
+				return
+			}
+			lastID = e.ID
+			flusher.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeSSEEvent(w http.ResponseWriter, e entity.Event) error {
+	data, err := json.Marshal(eventPayload{
+		ID:            e.ID,
+		Type:          e.Type,
+		PullRequestID: e.PRID,
+		AuthorID:      e.AuthorID,
+		TeamName:      e.TeamName,
+		ReviewerID:    e.ReviewerID,
+		CreatedAt:     e.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
@@ -2,16 +2,19 @@ package handlers
 
 import (
 	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/health"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
 type Handlers struct {
 	gen.Unimplemented
 	service usecase.Service
+	health  *health.Checker
 }
 
-func NewHandlers(service usecase.Service) gen.ServerInterface {
+func NewHandlers(service usecase.Service, checker *health.Checker) gen.ServerInterface {
 	return &Handlers{
 		service: service,
+		health:  checker,
 	}
 }
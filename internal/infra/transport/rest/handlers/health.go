@@ -3,10 +3,42 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+
+	"github.com/mark47B/be-internship/internal/infra/health"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
 func (h *Handlers) GetHealth(w http.ResponseWriter, r *http.Request) {
+	h.GetHealthLive(w, r)
+}
+
+// GetHealthLive не трогает зависимости: при недоступной БД под перезапускать бесполезно
+func (h *Handlers) GetHealthLive(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
 }
+
+func (h *Handlers) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	report := h.health.Ready(r.Context())
+
+	resp := gen.HealthReport{
+		Status:     gen.HealthReportStatus(report.Status),
+		Components: make(map[string]gen.HealthComponent, len(report.Components)),
+	}
+	for name, c := range report.Components {
+		comp := gen.HealthComponent{Status: gen.HealthComponentStatus(c.Status)}
+		if c.Message != "" {
+			comp.Message = &c.Message
+		}
+		resp.Components[name] = comp
+	}
+
+	status := http.StatusOK
+	if report.Status != health.StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -3,12 +3,48 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
-func WriteError(w http.ResponseWriter, code int, err gen.ErrorResponse) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(code)
-	_ = json.NewEncoder(w).Encode(err)
+// writeError — единая точка отдачи ошибок, маппинг usecase.Err* живёт в apierror
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	apierror.Write(w, r, err)
+}
+
+// decodeJSON разбирает тело запроса; при ошибке сам отвечает 400 и возвращает false
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, r, apierror.Validation("invalid json body",
+			gen.FieldError{Field: "body", Message: "invalid json body"}))
+		return false
+	}
+	return true
+}
+
+// setETag отдаёт версию ресурса строгим ETag вида "3"
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion разбирает If-Match в ожидаемую версию; нет заголовка или "*" — 0 (без проверки).
+// Принимается один строгий ETag из ответа сервиса, иначе сам отвечает 400 и возвращает false.
+func ifMatchVersion(w http.ResponseWriter, r *http.Request, header *string) (int64, bool) {
+	if header == nil || strings.TrimSpace(*header) == "*" {
+		return 0, true
+	}
+
+	tag, err := strconv.Unquote(strings.TrimSpace(*header))
+	if err == nil {
+		if version, err := strconv.ParseInt(tag, 10, 64); err == nil && version > 0 {
+			return version, true
+		}
+	}
+
+	writeError(w, r, apierror.Validation("invalid If-Match",
+		gen.FieldError{Field: "If-Match", Message: "expected a single ETag returned by the service"}))
+	return 0, false
 }
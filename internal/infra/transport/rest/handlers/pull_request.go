@@ -2,64 +2,49 @@ package handlers
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 
-	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
+// GET /pullRequest/get
+func (h *Handlers) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params gen.GetPullRequestGetParams) {
+	pr, err := h.service.GetPR(r.Context(), params.PullRequestId)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := gen.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            gen.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.Reviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+	}
+
+	setETag(w, pr.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"pr": resp,
+	})
+}
+
 // POST /pullRequest/create
-func (h *Handlers) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+func (h *Handlers) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, _ gen.PostPullRequestCreateParams) {
 	var req gen.PostPullRequestCreateJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: "invalid json body",
-			},
-		})
+	if !decodeJSON(w, r, &req) {
 		return
 	}
 
-	pr, err := h.service.CreatePR(r.Context(), req.PullRequestId, req.PullRequestName, req.AuthorId)
+	pr, err := h.service.CreatePR(r.Context(), req.PullRequestId, req.PullRequestName, req.AuthorId, noCandidateMode(req.OnNoCandidate))
 	if err != nil {
-		if errors.Is(err, usecase.ErrUserNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "author or team not found",
-				},
-			})
-			return
-		}
-		if errors.Is(err, usecase.ErrPRExists) {
-			WriteError(w, http.StatusConflict, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.PREXISTS,
-					Message: "PR id already exists",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
@@ -73,6 +58,7 @@ func (h *Handlers) PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
 		MergedAt:          pr.MergedAt,
 	}
 
+	setETag(w, pr.Version)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 	_ = json.NewEncoder(w).Encode(map[string]interface{}{
@@ -81,44 +67,19 @@ func (h *Handlers) PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
 }
 
 // POST /pullRequest/merge
-func (h *Handlers) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+func (h *Handlers) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params gen.PostPullRequestMergeParams) {
 	var req gen.PostPullRequestMergeJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: "invalid json body",
-			},
-		})
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	ifVersion, ok := ifMatchVersion(w, r, params.IfMatch)
+	if !ok {
 		return
 	}
 
-	pr, err := h.service.MergePR(r.Context(), req.PullRequestId)
+	pr, err := h.service.MergePR(r.Context(), req.PullRequestId, ifVersion)
 	if err != nil {
-		if errors.Is(err, usecase.ErrPRNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "pull request not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
@@ -132,6 +93,7 @@ func (h *Handlers) PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
 		MergedAt:          pr.MergedAt,
 	}
 
+	setETag(w, pr.Version)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(map[string]interface{}{
@@ -140,68 +102,20 @@ func (h *Handlers) PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
 }
 
 // POST /pullRequest/reassign
-func (h *Handlers) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+func (h *Handlers) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params gen.PostPullRequestReassignParams) {
 	var req gen.PostPullRequestReassignJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: "invalid json body",
-			},
-		})
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	ifVersion, ok := ifMatchVersion(w, r, params.IfMatch)
+	if !ok {
 		return
 	}
 
-	pr, replacedBy, err := h.service.ReassignReviewer(r.Context(), req.PullRequestId, req.OldUserId)
+	pr, replacedBy, err := h.service.ReassignReviewer(r.Context(), req.PullRequestId, req.OldUserId, ifVersion,
+		noCandidateMode(req.OnNoCandidate))
 	if err != nil {
-		if errors.Is(err, usecase.ErrPRNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "pull request or user not found",
-				},
-			})
-			return
-		}
-		if errors.Is(err, usecase.ErrAlreadyMerged) {
-			WriteError(w, http.StatusConflict, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.PRMERGED,
-					Message: "cannot reassign on merged PR",
-				},
-			})
-			return
-		}
-		if errors.Is(err, usecase.ErrNotReviewer) {
-			WriteError(w, http.StatusConflict, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTASSIGNED,
-					Message: "reviewer is not assigned to this PR",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
@@ -223,41 +137,70 @@ func (h *Handlers) PostPullRequestReassign(w http.ResponseWriter, r *http.Reques
 		responseBody["replaced_by"] = replacedBy
 	}
 
+	setETag(w, pr.Version)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(responseBody)
 }
 
 // GET /pullRequest/stats
-func (h *Handlers) GetPullRequestStats(w http.ResponseWriter, r *http.Request) {
-	stats, err := h.service.GetPRStats(r.Context())
+func (h *Handlers) GetPullRequestStats(w http.ResponseWriter, r *http.Request, params gen.GetPullRequestStatsParams) {
+	filter := entity.StatsFilter{From: params.From, To: params.To}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		writeError(w, r, apierror.Validation("invalid date range",
+			gen.FieldError{Field: "to", Message: "must be after from"}))
+		return
+	}
+
+	stats, err := h.service.GetPRStats(r.Context(), filter)
 	if err != nil {
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(toGenPRStats(stats))
+}
+
+func toGenPRStats(stats entity.PRStats) gen.PRStats {
 	var avgReviewers *float32
 	if stats.AvgReviewers > 0 {
 		avg := float32(stats.AvgReviewers)
 		avgReviewers = &avg
 	}
 
-	resp := gen.PRStats{
-		Total:        stats.Total,
-		Open:         stats.Open,
-		Merged:       stats.Merged,
-		AvgReviewers: avgReviewers,
+	mergeTime := toGenDurationStats(stats.MergeTime)
+	firstReviewTime := toGenDurationStats(stats.FirstReviewTime)
+	return gen.PRStats{
+		Total:           stats.Total,
+		Open:            stats.Open,
+		Merged:          stats.Merged,
+		AvgReviewers:    avgReviewers,
+		MergeTime:       &mergeTime,
+		FirstReviewTime: &firstReviewTime,
 	}
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(resp)
+// toGenDurationStats — на пустой выборке отдаём только count: нулевые часы выглядели бы как данные
+func toGenDurationStats(d entity.DurationStats) gen.DurationStats {
+	out := gen.DurationStats{Count: d.Count}
+	if d.Count > 0 {
+		out.AvgHours = &d.AvgHours
+		out.MedianHours = &d.MedianHours
+		out.P90Hours = &d.P90Hours
+		out.P99Hours = &d.P99Hours
+	}
+	return out
+}
+
+// noCandidateMode — по умолчанию PR остаётся без ревьювера, как до появления on_no_candidate
+func noCandidateMode(m *gen.NoCandidateMode) entity.NoCandidateMode {
+	if m == nil {
+		return entity.NoCandidateEmpty
+	}
+	return entity.NoCandidateMode(*m)
 }
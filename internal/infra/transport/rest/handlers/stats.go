@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// Периоды /stats/timeseries и /users/leaderboard, если from не задан
+const (
+	defaultTimeseriesDays  = 90
+	defaultLeaderboardDays = 30
+)
+
+// GET /stats/timeseries
+func (h *Handlers) GetStatsTimeseries(w http.ResponseWriter, r *http.Request, params gen.GetStatsTimeseriesParams) {
+	filter := entity.TimeseriesFilter{Granularity: entity.GranularityDay}
+	if params.Granularity != nil {
+		filter.Granularity = entity.Granularity(*params.Granularity)
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+	if filter.TeamName != "" && filter.UserID != "" {
+		writeError(w, r, apierror.Validation("ambiguous filter",
+			gen.FieldError{Field: "user_id", Message: "must not be combined with team_name"}))
+		return
+	}
+
+	// По умолчанию — последние 90 дней, включая сегодняшний
+	today := time.Now()
+	filter.To = time.Date(today.Year(), today.Month(), today.Day()+1, 0, 0, 0, 0, time.UTC)
+	if params.To != nil {
+		filter.To = params.To.Time
+	}
+	filter.From = filter.To.AddDate(0, 0, -defaultTimeseriesDays)
+	if params.From != nil {
+		filter.From = params.From.Time
+	}
+	if !filter.From.Before(filter.To) {
+		writeError(w, r, apierror.Validation("invalid date range",
+			gen.FieldError{Field: "to", Message: "must be after from"}))
+		return
+	}
+
+	points, err := h.service.GetTimeseries(r.Context(), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]gen.TimeseriesPoint, len(points))
+	for i, p := range points {
+		resp[i] = gen.TimeseriesPoint{
+			Start:            openapi_types.Date{Time: p.Start},
+			PrsOpened:        p.PRsOpened,
+			PrsMerged:        p.PRsMerged,
+			ReviewsAssigned:  p.ReviewsAssigned,
+			Reassignments:    p.Reassignments,
+			MedianMergeHours: p.MedianMergeHours,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// GET /users/leaderboard
+func (h *Handlers) GetUsersLeaderboard(w http.ResponseWriter, r *http.Request, params gen.GetUsersLeaderboardParams) {
+	filter := entity.LeaderboardFilter{To: time.Now(), SortBy: entity.LeaderboardByCompleted}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.SortBy != nil {
+		filter.SortBy = entity.LeaderboardSort(*params.SortBy)
+	}
+	if params.To != nil {
+		filter.To = *params.To
+	}
+	filter.From = filter.To.AddDate(0, 0, -defaultLeaderboardDays)
+	if params.From != nil {
+		filter.From = *params.From
+	}
+	if !filter.From.Before(filter.To) {
+		writeError(w, r, apierror.Validation("invalid date range",
+			gen.FieldError{Field: "to", Message: "must be after from"}))
+		return
+	}
+
+	entries, err := h.service.GetLeaderboard(r.Context(), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if params.Format != nil && *params.Format == gen.GetUsersLeaderboardParamsFormatCsv {
+		writeLeaderboardCSV(w, r, entries)
+		return
+	}
+
+	resp := make([]gen.LeaderboardEntry, len(entries))
+	for i, e := range entries {
+		resp[i] = gen.LeaderboardEntry{
+			Rank:                e.Rank,
+			UserId:              e.UserID,
+			Username:            e.Username,
+			TeamName:            e.TeamName,
+			IsActive:            e.IsActive,
+			ReviewsCompleted:    e.ReviewsCompleted,
+			OpenReviews:         e.OpenReviews,
+			AvgTurnaroundHours:  e.AvgTurnaroundHours,
+			AssignmentsReceived: e.AssignmentsReceived,
+			ReassignedAway:      e.ReassignedAway,
+			ReassignAwayRate:    e.ReassignAwayRate,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// writeLeaderboardCSV — те же поля, что в JSON; null — пустая ячейка
+func writeLeaderboardCSV(w http.ResponseWriter, r *http.Request, entries []entity.LeaderboardEntry) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="leaderboard.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"rank", "user_id", "username", "team_name", "is_active", "reviews_completed", "open_reviews",
+		"avg_turnaround_hours", "assignments_received", "reassigned_away", "reassign_away_rate"})
+	for _, e := range entries {
+		_ = cw.Write([]string{
+			strconv.Itoa(e.Rank), e.UserID, e.Username, e.TeamName, strconv.FormatBool(e.IsActive),
+			strconv.Itoa(e.ReviewsCompleted), strconv.Itoa(e.OpenReviews), optional(e.AvgTurnaroundHours),
+			strconv.Itoa(e.AssignmentsReceived), strconv.Itoa(e.ReassignedAway), optional(e.ReassignAwayRate),
+		})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		slog.ErrorContext(r.Context(), "failed to write leaderboard csv", "error", err)
+	}
+}
@@ -1,13 +1,13 @@
 package handlers
 
 import (
-	"database/sql"
 	"encoding/json"
-	"errors"
 	"net/http"
+	"time"
 
 	"github.com/mark47B/be-internship/internal/domain/entity"
-	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
@@ -15,35 +15,25 @@ import (
 func (h *Handlers) GetTeamGet(w http.ResponseWriter, r *http.Request, params gen.GetTeamGetParams) {
 	team, err := h.service.GetTeam(r.Context(), params.TeamName)
 	if err != nil {
-
-		if err == sql.ErrNoRows || errors.Is(err, usecase.ErrTeamNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "team not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
+	setETag(w, team.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(toGenTeam(team))
+
+}
+
+func toGenTeam(team entity.Team) gen.Team {
 	resp := gen.Team{
 		TeamName: team.Name,
 		Members:  make([]gen.TeamMember, len(team.Members)),
 	}
+	if team.ParentName != "" {
+		resp.ParentTeam = &team.ParentName
+	}
 
 	for i, m := range team.Members {
 		resp.Members[i] = gen.TeamMember{
@@ -52,26 +42,258 @@ func (h *Handlers) GetTeamGet(w http.ResponseWriter, r *http.Request, params gen
 			IsActive: m.IsActive,
 		}
 	}
+	return resp
+}
+
+// POST /team/parent
+func (h *Handlers) PostTeamParent(w http.ResponseWriter, r *http.Request, _ gen.PostTeamParentParams) {
+	var req gen.PostTeamParentJSONRequestBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	var parent string
+	if req.ParentTeam != nil {
+		parent = *req.ParentTeam
+	}
+
+	team, err := h.service.GetTeam(r.Context(), req.TeamName)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// Перенос меняет и старое, и новое поддерево: нужны права на все три команды
+	for _, name := range []string{team.Name, team.ParentName, parent} {
+		if name == "" {
+			continue
+		}
+		if err := auth.CheckTeam(r.Context(), name); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+
+	moved, err := h.service.SetTeamParent(r.Context(), req.TeamName, parent)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	setETag(w, moved.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"team": toGenTeam(moved),
+	})
+}
+
+// GET /team/tree
+func (h *Handlers) GetTeamTree(w http.ResponseWriter, r *http.Request, params gen.GetTeamTreeParams) {
+	var root string
+	if params.TeamName != nil {
+		root = *params.TeamName
+	}
+	trees, err := h.service.GetTeamTree(r.Context(), root)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]gen.TeamNode, len(trees))
+	for i, t := range trees {
+		resp[i] = toGenTeamNode(t)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"teams": resp,
+	})
+}
+
+func toGenTeamNode(n entity.TeamNode) gen.TeamNode {
+	resp := gen.TeamNode{
+		TeamName: n.Name,
+		Own:      toGenTeamCounts(n.Own),
+		Total:    toGenTeamCounts(n.Total),
+		Subteams: make([]gen.TeamNode, len(n.Subteams)),
+	}
+	if n.ParentName != "" {
+		resp.ParentTeam = &n.ParentName
+	}
+	for i, c := range n.Subteams {
+		resp.Subteams[i] = toGenTeamNode(c)
+	}
+	return resp
+}
+
+func toGenTeamCounts(c entity.TeamCounts) gen.TeamCounts {
+	return gen.TeamCounts{
+		Members:       c.Members,
+		ActiveMembers: c.ActiveMembers,
+		OpenPrs:       c.OpenPRs,
+		MergedPrs:     c.MergedPRs,
+		OpenReviews:   c.OpenReviews,
+	}
+}
+
+// GET /team/stats
+func (h *Handlers) GetTeamStats(w http.ResponseWriter, r *http.Request, params gen.GetTeamStatsParams) {
+	subteams := params.IncludeSubteams != nil && *params.IncludeSubteams
+	stats, err := h.service.GetTeamStats(r.Context(), params.TeamName, subteams)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := gen.TeamStats{
+		TeamName:          stats.TeamName,
+		IncludeSubteams:   stats.WithSubteams,
+		PullRequests:      toGenPRStats(stats.PRs),
+		Members:           make([]gen.MemberLoad, len(stats.Members)),
+		LoadGini:          stats.LoadGini,
+		RequiredReviewers: stats.RequiredReviewers,
+		Understaffed:      make([]gen.PullRequest, len(stats.Understaffed)),
+	}
+	for i, m := range stats.Members {
+		resp.Members[i] = gen.MemberLoad{
+			UserId:      m.UserID,
+			Username:    m.Username,
+			TeamName:    m.TeamName,
+			IsActive:    m.IsActive,
+			OpenReviews: m.OpenReviews,
+		}
+	}
+	for i, pr := range stats.Understaffed {
+		resp.Understaffed[i] = gen.PullRequest{
+			PullRequestId:     pr.ID,
+			PullRequestName:   pr.Name,
+			AuthorId:          pr.AuthorID,
+			Status:            gen.PullRequestStatus(pr.Status),
+			AssignedReviewers: pr.Reviewers,
+			CreatedAt:         pr.CreatedAt,
+		}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// GET /team/sla
+func (h *Handlers) GetTeamSla(w http.ResponseWriter, r *http.Request, params gen.GetTeamSlaParams) {
+	sla, err := h.service.GetTeamSLA(r.Context(), params.TeamName)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeTeamSLA(w, sla)
+}
+
+// POST /team/sla
+func (h *Handlers) PostTeamSla(w http.ResponseWriter, r *http.Request, _ gen.PostTeamSlaParams) {
+	var req gen.PostTeamSlaJSONRequestBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := auth.CheckTeam(r.Context(), req.TeamName); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	sla := entity.SLA{RemindAfter: fromHours(req.RemindAfterHours)}
+	if req.ReassignAfterHours != nil {
+		sla.ReassignAfter = fromHours(*req.ReassignAfterHours)
+	}
+	// Схема пропускает сколь угодно малые часы, а интервал в Postgres и time.Duration — нет
+	if sla.RemindAfter <= 0 {
+		writeError(w, r, apierror.Validation("invalid SLA",
+			gen.FieldError{Field: "remind_after_hours", Message: "is too small"}))
+		return
+	}
+	saved, err := h.service.SetTeamSLA(r.Context(), req.TeamName, sla)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeTeamSLA(w, saved)
+}
+
+func writeTeamSLA(w http.ResponseWriter, sla entity.TeamSLA) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(gen.TeamSLA{
+		TeamName:           sla.TeamName,
+		RemindAfterHours:   sla.RemindAfter.Hours(),
+		ReassignAfterHours: sla.ReassignAfter.Hours(),
+		IsDefault:          sla.Default,
+	})
+}
+
+func fromHours(h float64) time.Duration {
+	return time.Duration(h * float64(time.Hour))
+}
+
+// GET /team/escalation
+func (h *Handlers) GetTeamEscalation(w http.ResponseWriter, r *http.Request, params gen.GetTeamEscalationParams) {
+	policy, err := h.service.GetTeamEscalation(r.Context(), params.TeamName)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeTeamEscalation(w, policy)
+}
+
+// POST /team/escalation
+func (h *Handlers) PostTeamEscalation(w http.ResponseWriter, r *http.Request, _ gen.PostTeamEscalationParams) {
+	var req gen.PostTeamEscalationJSONRequestBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := auth.CheckTeam(r.Context(), req.TeamName); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	policy := entity.EscalationPolicy{TeamName: req.TeamName}
+	if req.LeadUserId != nil {
+		policy.LeadUserID = *req.LeadUserId
+	}
+	if req.EscalationTeam != nil {
+		policy.EscalationTeam = *req.EscalationTeam
+	}
+	if policy.EscalationTeam == policy.TeamName {
+		writeError(w, r, apierror.Validation("invalid escalation",
+			gen.FieldError{Field: "escalation_team", Message: "must differ from team_name"}))
+		return
+	}
+	saved, err := h.service.SetTeamEscalation(r.Context(), policy)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeTeamEscalation(w, saved)
+}
 
+func writeTeamEscalation(w http.ResponseWriter, policy entity.EscalationPolicy) {
+	resp := gen.TeamEscalation{TeamName: policy.TeamName}
+	if policy.LeadUserID != "" {
+		resp.LeadUserId = &policy.LeadUserID
+	}
+	if policy.EscalationTeam != "" {
+		resp.EscalationTeam = &policy.EscalationTeam
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
 }
 
 // POST /team/add
-func (h *Handlers) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+func (h *Handlers) PostTeamAdd(w http.ResponseWriter, r *http.Request, _ gen.PostTeamAddParams) {
 	var req gen.PostTeamAddJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: "invalid json body",
-			},
-		})
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := auth.CheckTeam(r.Context(), req.TeamName); err != nil {
+		writeError(w, r, err)
 		return
 	}
 
@@ -92,59 +314,14 @@ func (h *Handlers) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 
 	createdTeam, err := h.service.AddOrUpdateTeam(r.Context(), teamEntity)
 	if err != nil {
-		if err == usecase.ErrTeamExists {
-			WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.TEAMEXISTS,
-					Message: err.Error(),
-				},
-			})
-			return
-		}
-		if errors.Is(err, usecase.ErrTeamNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "team not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
-	// Map back to gen.Team
-	teamResp := gen.Team{
-		TeamName: createdTeam.Name,
-		Members:  make([]gen.TeamMember, 0, len(createdTeam.Members)),
-	}
-
-	for _, m := range createdTeam.Members {
-		teamResp.Members = append(teamResp.Members, gen.TeamMember{
-			UserId:   m.ID,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		})
-	}
-
 	resp := map[string]interface{}{
-		"team": teamResp,
+		"team": toGenTeam(createdTeam),
 	}
+	setETag(w, createdTeam.Version)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 	_ = json.NewEncoder(w).Encode(resp)
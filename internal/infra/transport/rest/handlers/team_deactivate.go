@@ -2,52 +2,31 @@ package handlers
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 
-	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
 // PATCH /teams/{teamName}/deactivate-members
-func (h *Handlers) PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request, teamName string) {
+func (h *Handlers) PatchTeamsTeamNameDeactivateMembers(w http.ResponseWriter, r *http.Request, teamName string, params gen.PatchTeamsTeamNameDeactivateMembersParams) {
 	var req gen.PatchTeamsTeamNameDeactivateMembersJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: "invalid json body",
-			},
-		})
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := auth.CheckTeam(r.Context(), teamName); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	ifVersion, ok := ifMatchVersion(w, r, params.IfMatch)
+	if !ok {
 		return
 	}
 
-	err := h.service.DeactivateUsersAndReassign(r.Context(), teamName, req.UserIds)
+	err := h.service.DeactivateUsersAndReassign(r.Context(), teamName, req.UserIds, ifVersion)
 	if err != nil {
-		if errors.Is(err, usecase.ErrTeamNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "team not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
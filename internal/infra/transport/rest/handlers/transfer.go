@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/transfer"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// Предел тела импорта: файл читается пачками, но применяется одной транзакцией — её размер и ограничиваем
+const maxImportBodySize = 256 << 20
+
+// GET /admin/export
+func (h *Handlers) GetAdminExport(w http.ResponseWriter, r *http.Request, params gen.GetAdminExportParams) {
+	format := transfer.FormatJSONL
+	if params.Format != nil {
+		format = transfer.Format(*params.Format)
+	}
+
+	// Заголовки уходят с первой записью: до неё ошибку ещё можно отдать обычным ответом
+	started := false
+	enc := transfer.NewWriter(w, format)
+	err := h.service.Export(r.Context(), func(rec entity.TransferRecord) error {
+		if !started {
+			started = true
+			writeExportHeaders(w, format)
+		}
+		return enc.Write(rec)
+	})
+	if err == nil {
+		if !started {
+			writeExportHeaders(w, format)
+		}
+		err = enc.Flush()
+	}
+	if err == nil {
+		return
+	}
+	if !started {
+		writeError(w, r, err)
+		return
+	}
+
+	// Часть выгрузки уже отдана: рвём соединение, чтобы клиент не принял обрезанный файл за полный
+	slog.ErrorContext(r.Context(), "export aborted", "error", err)
+	panic(http.ErrAbortHandler)
+}
+
+func writeExportHeaders(w http.ResponseWriter, format transfer.Format) {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="export.`+string(format)+`"`)
+	w.WriteHeader(http.StatusOK)
+}
+
+// POST /admin/import
+func (h *Handlers) PostAdminImport(w http.ResponseWriter, r *http.Request, params gen.PostAdminImportParams) {
+	format, err := transfer.FormatFromContentType(r.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, r, apierror.Validation("unsupported import format",
+			gen.FieldError{Field: "Content-Type", Message: err.Error()}))
+		return
+	}
+
+	// Файл разбирается по ходу импорта: ошибка формата или размера приходит из Import
+	src := transfer.NewReader(http.MaxBytesReader(w, r.Body, maxImportBodySize), format)
+	dryRun := params.DryRun != nil && *params.DryRun
+	result, err := h.service.Import(r.Context(), src, dryRun)
+	var tooLarge *http.MaxBytesError
+	status := http.StatusOK
+	switch {
+	case errors.Is(err, usecase.ErrImportConflict):
+		status = http.StatusConflict
+	case errors.As(err, &tooLarge):
+		writeError(w, r, apierror.Validation("import file is too large",
+			gen.FieldError{Field: "body", Message: "exceeds the size limit"}))
+		return
+	case errors.Is(err, usecase.ErrInvalidImport):
+		writeError(w, r, apierror.Validation("invalid import file",
+			gen.FieldError{Field: "body", Message: strings.TrimPrefix(err.Error(), usecase.ErrInvalidImport.Error()+": ")}))
+		return
+	case err != nil:
+		writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(importReport(result))
+}
+
+func importReport(res entity.ImportResult) gen.ImportReport {
+	counts := func(c entity.ImportCounts) gen.ImportCounts {
+		return gen.ImportCounts{Created: c.Created, Updated: c.Updated, Unchanged: c.Unchanged}
+	}
+	conflicts := make([]gen.ImportConflict, len(res.Conflicts))
+	for i, c := range res.Conflicts {
+		conflicts[i] = gen.ImportConflict{
+			Line:   c.Line,
+			Kind:   gen.ImportConflictKind(c.Kind),
+			Id:     c.ID,
+			Reason: c.Reason,
+		}
+	}
+	return gen.ImportReport{
+		DryRun:       res.DryRun,
+		Teams:        counts(res.Teams),
+		Users:        counts(res.Users),
+		PullRequests: counts(res.PullRequests),
+		Conflicts:    conflicts,
+	}
+}
@@ -1,54 +1,34 @@
 package handlers
 
 import (
-	"database/sql"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"time"
 
-	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 )
 
 // POST /users/setIsActive
-func (h *Handlers) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+func (h *Handlers) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, _ gen.PostUsersSetIsActiveParams) {
 	var req gen.PostUsersSetIsActiveJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		WriteError(w, http.StatusBadRequest, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: "invalid json body",
-			},
-		})
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := h.checkUserTeam(r.Context(), req.UserId); err != nil {
+		writeError(w, r, err)
 		return
 	}
 
 	user, err := h.service.SetUserActive(r.Context(), req.UserId, req.IsActive)
 	if err != nil {
-		if err == sql.ErrNoRows || errors.Is(err, usecase.ErrUserNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "user not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
@@ -66,31 +46,20 @@ func (h *Handlers) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// checkUserTeam — team_lead может менять только пользователей своей команды
+func (h *Handlers) checkUserTeam(ctx context.Context, userID string) error {
+	user, err := h.service.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return auth.CheckTeam(ctx, user.TeamName)
+}
+
 // GET /users/getReview
 func (h *Handlers) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params gen.GetUsersGetReviewParams) {
 	prs, err := h.service.GetUserReviewPRs(r.Context(), params.UserId)
 	if err != nil {
-		if errors.Is(err, usecase.ErrUserNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "user not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
@@ -118,27 +87,7 @@ func (h *Handlers) GetUsersGetReview(w http.ResponseWriter, r *http.Request, par
 func (h *Handlers) GetUsersStats(w http.ResponseWriter, r *http.Request, params gen.GetUsersStatsParams) {
 	stats, err := h.service.GetUserStats(r.Context(), params.UserId)
 	if err != nil {
-		if errors.Is(err, usecase.ErrUserNotFound) {
-			WriteError(w, http.StatusNotFound, gen.ErrorResponse{
-				Error: struct {
-					Code    gen.ErrorResponseErrorCode `json:"code"`
-					Message string                     `json:"message"`
-				}{
-					Code:    gen.NOTFOUND,
-					Message: "user not found",
-				},
-			})
-			return
-		}
-		WriteError(w, http.StatusInternalServerError, gen.ErrorResponse{
-			Error: struct {
-				Code    gen.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    gen.NOTFOUND,
-				Message: err.Error(),
-			},
-		})
+		writeError(w, r, err)
 		return
 	}
 
@@ -153,3 +102,103 @@ func (h *Handlers) GetUsersStats(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+// GET /users/notifications
+func (h *Handlers) GetUsersNotifications(w http.ResponseWriter, r *http.Request, params gen.GetUsersNotificationsParams) {
+	if err := h.checkUserTeam(r.Context(), params.UserId); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	prefs, err := h.service.GetNotificationPrefs(r.Context(), params.UserId)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeNotificationPrefs(w, prefs)
+}
+
+// POST /users/notifications
+func (h *Handlers) PostUsersNotifications(w http.ResponseWriter, r *http.Request, _ gen.PostUsersNotificationsParams) {
+	var req gen.PostUsersNotificationsJSONRequestBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	prefs := entity.NotificationPrefs{UserID: req.UserId, Channels: make([]entity.NotificationChannel, len(req.Channels))}
+	for i, c := range req.Channels {
+		prefs.Channels[i] = entity.NotificationChannel(c)
+	}
+	if req.Email != nil {
+		prefs.Email = string(*req.Email)
+	}
+	if prefs.Email == "" && slices.Contains(prefs.Channels, entity.ChannelEmail) {
+		writeError(w, r, apierror.Validation("invalid notification preferences",
+			gen.FieldError{Field: "email", Message: "is required for channel email"}))
+		return
+	}
+	if req.QuietHours != nil {
+		quiet, field, err := parseQuietHours(*req.QuietHours)
+		if err != nil {
+			writeError(w, r, apierror.Validation("invalid notification preferences",
+				gen.FieldError{Field: field, Message: err.Error()}))
+			return
+		}
+		prefs.QuietHours = &quiet
+	}
+
+	if err := h.checkUserTeam(r.Context(), req.UserId); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	saved, err := h.service.SetNotificationPrefs(r.Context(), prefs)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeNotificationPrefs(w, saved)
+}
+
+// parseQuietHours — формат времени уже проверен схемой; field — поле запроса с ошибкой
+func parseQuietHours(q gen.QuietHours) (entity.QuietHours, string, error) {
+	loc, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return entity.QuietHours{}, "quiet_hours.time_zone", errors.New("unknown time zone")
+	}
+	start, err := time.Parse("15:04", q.Start)
+	if err != nil {
+		return entity.QuietHours{}, "quiet_hours.start", errors.New("must be HH:MM")
+	}
+	end, err := time.Parse("15:04", q.End)
+	if err != nil {
+		return entity.QuietHours{}, "quiet_hours.end", errors.New("must be HH:MM")
+	}
+	if start.Equal(end) {
+		return entity.QuietHours{}, "quiet_hours.end", errors.New("must differ from start")
+	}
+	midnight := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+	return entity.QuietHours{Start: start.Sub(midnight), End: end.Sub(midnight), Location: loc}, "", nil
+}
+
+func writeNotificationPrefs(w http.ResponseWriter, prefs entity.NotificationPrefs) {
+	resp := gen.NotificationPrefs{
+		UserId:    prefs.UserID,
+		Channels:  make([]gen.NotificationChannel, len(prefs.Channels)),
+		IsDefault: prefs.Default,
+	}
+	for i, c := range prefs.Channels {
+		resp.Channels[i] = gen.NotificationChannel(c)
+	}
+	if prefs.Email != "" {
+		resp.Email = &prefs.Email
+	}
+	if q := prefs.QuietHours; q != nil {
+		clock := func(d time.Duration) string {
+			return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+		}
+		resp.QuietHours = &gen.QuietHours{Start: clock(q.Start), End: clock(q.End), TimeZone: q.Location.String()}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/logging"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+)
+
+// rolesExtension — расширение операции в api/openapi.yml со списком допустимых ролей
+const rolesExtension = "x-roles"
+
+// Authenticate проверяет X-API-Key или Authorization: Bearer для операций, у которых
+// в спецификации объявлен security, и пускает только роли из x-roles операции.
+// Принципал кладётся в контекст (auth.FromContext) для проверок на уровне команды, его арендатор —
+// в tenant.NewContext: по нему хранилище разделяет данные. X-Tenant-ID — см. auth.Authenticate.
+func Authenticate(swagger *openapi3.T, authn *auth.Authenticator) (func(http.Handler) http.Handler, error) {
+	swagger.Servers = nil
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router: %w", err)
+	}
+
+	roles, err := operationRoles(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, _, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			security := route.Operation.Security
+			if security == nil {
+				security = &swagger.Security
+			}
+			if len(*security) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bearer, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			principal, err := authn.Authenticate(r.Context(), r.Header.Get("X-API-Key"), bearer, r.Header.Get("X-Tenant-ID"))
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="pr-reviewer"`)
+				apierror.Write(w, r, err)
+				return
+			}
+
+			if err := auth.Allow(principal, roles[route.Operation]); err != nil {
+				apierror.Write(w, r, err)
+				return
+			}
+
+			logging.AddAttrs(r.Context(), slog.String("user", principal.Subject), slog.String("role", string(principal.Role)),
+				slog.String("tenant", principal.TenantID))
+			ctx := tenant.NewContext(auth.NewContext(r.Context(), principal), principal.TenantID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}, nil
+}
+
+// operationRoles читает x-roles всех защищённых операций. Защищённая операция
+// без x-roles — ошибка в спецификации: без неё доступ был бы закрыт всем.
+func operationRoles(swagger *openapi3.T) (map[*openapi3.Operation][]entity.Role, error) {
+	res := make(map[*openapi3.Operation][]entity.Role)
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.Security != nil && len(*op.Security) == 0 {
+				continue
+			}
+
+			raw, ok := op.Extensions[rolesExtension].([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s %s: missing %s", method, path, rolesExtension)
+			}
+			for _, v := range raw {
+				role := entity.Role(fmt.Sprint(v))
+				if !role.Valid() {
+					return nil, fmt.Errorf("%s %s: unknown role %q", method, path, role)
+				}
+				res[op] = append(res[op], role)
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,181 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/repository"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader — ответ взят из сохранённого, а не выполнен заново
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotency сохраняет ответы на POST/PATCH с заголовком Idempotency-Key и отдаёт их
+// при повторе того же запроса. Повтор ключа с другим телом — 422, пока первый запрос
+// выполняется — 409. Ответы 5xx не сохраняются: такой запрос можно повторить с тем же ключом.
+// Незавершённый запрос держит ключ не дольше lease: если процесс упал, ключ освобождается сам.
+func Idempotency(store repository.IdempotencyRepository, ttl, lease time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || (r.Method != http.MethodPost && r.Method != http.MethodPatch) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				apierror.Write(w, r, apierror.Validation("invalid request body",
+					gen.FieldError{Field: "body", Message: "failed to read body"}))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			rec := entity.IdempotencyRecord{
+				Scope:       idempotencyScope(r.Context()),
+				Key:         key,
+				RequestHash: requestFingerprint(r, body),
+			}
+
+			existing, claimed, err := store.Claim(r.Context(), rec, ttl, lease)
+			if err != nil {
+				apierror.Write(w, r, err)
+				return
+			}
+			if !claimed {
+				replay(w, r, rec, existing)
+				return
+			}
+
+			// Запрос уже выполняется, поэтому результат сохраняем даже если клиент отвалился
+			ctx := context.WithoutCancel(r.Context())
+			completed := false
+			defer func() {
+				// 5xx или паника: ключ освобождается, чтобы клиент мог повторить запрос
+				if completed {
+					return
+				}
+				if err := store.Release(ctx, rec.Scope, rec.Key); err != nil {
+					slog.ErrorContext(ctx, "release idempotency key", "key", rec.Key, "error", err)
+				}
+			}()
+
+			// Заголовки внешних middleware (X-Request-ID и т.п.) относятся к этому запросу, а не к ответу
+			before := w.Header().Clone()
+			resp := &bufferedResponse{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(resp, r)
+
+			if resp.status < http.StatusInternalServerError {
+				rec.StatusCode = resp.status
+				rec.Header = handlerHeaders(before, resp.Header())
+				rec.Body = resp.body.Bytes()
+				if err := store.Complete(ctx, rec); err != nil {
+					slog.ErrorContext(ctx, "save idempotent response", "key", rec.Key, "error", err)
+				} else {
+					completed = true
+				}
+			}
+
+			w.WriteHeader(resp.status)
+			_, _ = w.Write(resp.body.Bytes())
+		})
+	}
+}
+
+func replay(w http.ResponseWriter, r *http.Request, rec, existing entity.IdempotencyRecord) {
+	if existing.RequestHash != rec.RequestHash {
+		apierror.Write(w, r, &apierror.Error{
+			Status:  http.StatusUnprocessableEntity,
+			Code:    gen.IDEMPOTENCYKEYREUSED,
+			Message: "Idempotency-Key was already used with a different request",
+		})
+		return
+	}
+	if !existing.Completed() {
+		apierror.Write(w, r, &apierror.Error{
+			Status:  http.StatusConflict,
+			Code:    gen.CONFLICT,
+			Message: "request with this Idempotency-Key is still in progress",
+		})
+		return
+	}
+
+	for name, values := range existing.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(existing.StatusCode)
+	_, _ = w.Write(existing.Body)
+}
+
+// handlerHeaders — заголовки, которые выставил или изменил хендлер
+func handlerHeaders(before, after http.Header) map[string][]string {
+	header := make(map[string][]string)
+	for name, values := range after {
+		if !slices.Equal(before[name], values) {
+			header[name] = values
+		}
+	}
+	return header
+}
+
+// idempotencyScope — ключи разных клиентов не пересекаются
+func idempotencyScope(ctx context.Context) string {
+	if p, ok := auth.FromContext(ctx); ok {
+		return p.Subject
+	}
+	return ""
+}
+
+// requestFingerprint — метод, путь с query и тело без незначащих пробелов
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, body); err == nil {
+		h.Write(compact.Bytes())
+	} else {
+		h.Write(body)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// bufferedResponse копит ответ хендлера, чтобы сохранить его перед отправкой
+type bufferedResponse struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (b *bufferedResponse) WriteHeader(code int) {
+	if b.wroteHeader {
+		return
+	}
+	b.wroteHeader = true
+	b.status = code
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	if !b.wroteHeader {
+		b.WriteHeader(http.StatusOK)
+	}
+	return b.body.Write(p)
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+)
+
+// Recoverer превращает панику хендлера в 500 INTERNAL; стек уходит только в лог
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				// Штатный способ оборвать ответ, отдаём его net/http
+				panic(rec)
+			}
+			apierror.Write(w, r, apierror.Internal(fmt.Errorf("panic: %v\n%s", rec, debug.Stack())))
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,222 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/apierror"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+type ValidatorOptions struct {
+	// ValidateResponses — проверять и ответы (для тестового окружения).
+	// Ответ, не соответствующий спецификации, заменяется на 500 VALIDATION_ERROR.
+	ValidateResponses bool
+}
+
+// OpenAPIValidator проверяет запросы по спецификации (gen.GetSwagger()):
+// обязательные поля, enum, форматы, minLength и т.д. Невалидный запрос получает 400 VALIDATION_ERROR
+// с перечнем полей и до хендлера не доходит. Неизвестные маршруты пропускаются — их обработает chi.
+func OpenAPIValidator(swagger *openapi3.T, opts ValidatorOptions) (func(http.Handler) http.Handler, error) {
+	// Валидируем по path без учёта host/servers
+	swagger.Servers = nil
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router: %w", err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Исторически клиенты шлют JSON без Content-Type — не ломаем их
+			if r.ContentLength != 0 && r.Header.Get("Content-Type") == "" {
+				r.Header.Set("Content-Type", "application/json")
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					MultiError:         true,
+					AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+					// Файл импорта разбирает хендлер: валидатор прочитал бы его в память целиком
+					ExcludeRequestBody: isStreamed(r.Header.Get("Content-Type")),
+				},
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				apierror.Write(w, r, apierror.Validation("request does not match API specification", requestFieldErrors(err)...))
+				return
+			}
+
+			if !opts.ValidateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			if rec.streaming {
+				return
+			}
+
+			if err := validateResponse(r, input, rec); err != nil {
+				apierror.Write(w, r, &apierror.Error{
+					Status:  http.StatusInternalServerError,
+					Code:    gen.VALIDATIONERROR,
+					Message: "response does not match API specification",
+					Details: []gen.FieldError{{Field: "response", Message: err.Error()}},
+					Err:     err,
+				})
+				return
+			}
+
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+		})
+	}, nil
+}
+
+func validateResponse(r *http.Request, input *openapi3filter.RequestValidationInput, rec *responseRecorder) error {
+	respInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rec.status,
+		Header:                 rec.Header(),
+		Options:                &openapi3filter.Options{MultiError: true},
+	}
+	respInput.SetBodyBytes(rec.body.Bytes())
+
+	return openapi3filter.ValidateResponse(r.Context(), respInput)
+}
+
+// requestFieldErrors раскладывает ошибку kin-openapi на ошибки по полям
+func requestFieldErrors(err error) []gen.FieldError {
+	var details []gen.FieldError
+
+	// RequestError сам разворачивается в MultiError ошибок схемы, поэтому без errors.As
+	if multi, ok := err.(openapi3.MultiError); ok {
+		for _, e := range multi {
+			details = append(details, requestFieldErrors(e)...)
+		}
+		return details
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return []gen.FieldError{{Field: "request", Message: err.Error()}}
+	}
+
+	if p := reqErr.Parameter; p != nil {
+		return []gen.FieldError{{Field: p.Name, Message: reasonOf(reqErr)}}
+	}
+
+	// Ошибки тела: схема (возможно несколько) или разбор JSON
+	if reqErr.Err != nil {
+		var schemaMulti openapi3.MultiError
+		if errors.As(reqErr.Err, &schemaMulti) {
+			for _, e := range schemaMulti {
+				details = append(details, schemaFieldError(e))
+			}
+			return details
+		}
+		var schemaErr *openapi3.SchemaError
+		if errors.As(reqErr.Err, &schemaErr) {
+			return []gen.FieldError{schemaFieldError(schemaErr)}
+		}
+		var parseErr *openapi3filter.ParseError
+		if errors.As(reqErr.Err, &parseErr) {
+			return []gen.FieldError{{Field: "body", Message: "invalid json body"}}
+		}
+	}
+
+	return []gen.FieldError{{Field: "body", Message: reasonOf(reqErr)}}
+}
+
+func schemaFieldError(err error) gen.FieldError {
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return gen.FieldError{Field: "body", Message: err.Error()}
+	}
+
+	field := strings.Join(schemaErr.JSONPointer(), ".")
+	if field == "" {
+		field = "body"
+	}
+	return gen.FieldError{Field: field, Message: schemaErr.Reason}
+}
+
+func reasonOf(err *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if err.Err != nil {
+		return err.Err.Error()
+	}
+	return err.Reason
+}
+
+// Тела, которые передаются потоком и не проверяются по схеме: SSE, выгрузка и импорт
+var streamedContentTypes = []string{"text/event-stream", "application/x-ndjson", "text/csv"}
+
+func isStreamed(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && slices.Contains(streamedContentTypes, mediaType)
+}
+
+// responseRecorder буферизует ответ для проверки по спецификации.
+// Потоковые ответы (isStreamed) пропускаются как есть.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+	streaming   bool
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.status = code
+
+	if isStreamed(r.Header().Get("Content-Type")) {
+		r.streaming = true
+		r.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	if r.streaming {
+		return r.ResponseWriter.Write(b)
+	}
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) Flush() {
+	if !r.streaming {
+		return
+	}
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
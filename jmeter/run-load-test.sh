@@ -7,6 +7,7 @@ RAMP_UP="${RAMP_UP:-10}"
 LOOPS="${LOOPS:-100}"
 HOST="${HOST:-app}"
 PORT="${PORT:-8080}"
+API_KEY="${API_KEY:-dev-admin-key}"
 
 TEST_PLAN="/tests/load-test.jmx"
 RESULTS="/reports/results.csv"
@@ -28,7 +29,7 @@ echo "========================================="
 # Обычная проверка доступности приложения (можно оставить)
 echo "Waiting for app to be ready..."
 for i in {1..30}; do
-  if curl -s "http://$HOST:$PORT/health" | grep -q '"status":"ok"'; then
+  if curl -sf "http://$HOST:$PORT/health/ready" | grep -q '"status":"ok"'; then
     echo "App is ready!"
     break
   fi
@@ -41,7 +42,7 @@ echo "Starting JMeter load test..."
 jmeter -n -t "$TEST_PLAN" \
   -l "$RESULTS" \
   -j "$JMETER_LOG" \
-  -JTHREADS="$THREADS" -JRAMP_UP="$RAMP_UP" -JLOOPS="$LOOPS" -JHOST="$HOST" -JPORT="$PORT"
+  -JTHREADS="$THREADS" -JRAMP_UP="$RAMP_UP" -JLOOPS="$LOOPS" -JHOST="$HOST" -JPORT="$PORT" -JAPI_KEY="$API_KEY"
 
 echo "Load test finished. Results saved to: $RESULTS"
 echo "Checking results before generating HTML report..."
@@ -0,0 +1,266 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+const testIssuer = "e2e-issuer"
+
+// jwtIssuer подписывает токены ключом, публичная часть которого лежит в JWKS-файле
+type jwtIssuer struct {
+	key      *rsa.PrivateKey
+	jwksFile string
+}
+
+func newJWTIssuer(t *testing.T) *jwtIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "e2e-key", Algorithm: string(jose.RS256), Use: "sig"},
+	}}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(file, data, 0o600))
+
+	return &jwtIssuer{key: key, jwksFile: file}
+}
+
+func (i *jwtIssuer) token(t *testing.T, key *rsa.PrivateKey, issuer string, ttl time.Duration, role entity.Role, team string) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithHeader("kid", "e2e-key"),
+	)
+	require.NoError(t, err)
+
+	now := time.Now()
+	tok, err := jwt.Signed(signer).
+		Claims(jwt.Claims{
+			Subject:  "e2e-user",
+			Issuer:   issuer,
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(ttl)),
+		}).
+		Claims(map[string]any{"role": role, "team": team}).
+		Serialize()
+	require.NoError(t, err)
+	return tok
+}
+
+// doAs выполняет запрос с заданными заголовками авторизации (без ключа по умолчанию)
+func (c *testClient) doAs(t *testing.T, method, path string, body any, headers map[string]string) *http.Response {
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, &buf)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	return resp
+}
+
+func requireErrorCode(t *testing.T, resp *http.Response, status int, code gen.ErrorCode) {
+	t.Helper()
+	defer resp.Body.Close()
+
+	require.Equal(t, status, resp.StatusCode)
+	var body gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, code, body.Error.Code)
+}
+
+func TestAuth(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	issuer := newJWTIssuer(t)
+
+	keys := pg.NewAPIKeyStorage(db)
+	for _, k := range []struct {
+		name, key string
+		role      entity.Role
+		team      string
+	}{
+		{"admin", adminAPIKey, entity.RoleAdmin, ""},
+		{"lead", "lead-key", entity.RoleTeamLead, "auth-alpha"},
+		{"member", "member-key", entity.RoleMember, ""},
+		{"reader", "reader-key", entity.RoleReadOnly, ""},
+	} {
+		_, err := auth.SaveAPIKey(ctx, keys, k.name, k.key, k.role, k.team)
+		require.NoError(t, err)
+	}
+
+	authn, err := auth.NewAuthenticator(keys, pg.NewTeamStorage(db), pg.NewTenantStorage(db), auth.Options{JWKSFile: issuer.jwksFile, Issuer: testIssuer})
+	require.NoError(t, err)
+
+	client := newTestClientWith(db, authn)
+	t.Cleanup(client.Close)
+
+	for _, team := range []string{"auth-alpha", "auth-beta"} {
+		resp := client.post(t, "/team/add", gen.Team{
+			TeamName: team,
+			Members: []gen.TeamMember{
+				{UserId: team + "-u1", Username: "One", IsActive: true},
+				{UserId: team + "-u2", Username: "Two", IsActive: true},
+				{UserId: team + "-u3", Username: "Three", IsActive: true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	apiKey := func(key string) map[string]string { return map[string]string{"X-API-Key": key} }
+	bearer := func(tok string) map[string]string { return map[string]string{"Authorization": "Bearer " + tok} }
+
+	t.Run("Missing or unknown credentials", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, nil)
+		assert.NotEmpty(t, resp.Header.Get("WWW-Authenticate"))
+		requireErrorCode(t, resp, http.StatusUnauthorized, gen.UNAUTHORIZED)
+
+		resp = client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, apiKey("unknown-key"))
+		requireErrorCode(t, resp, http.StatusUnauthorized, gen.UNAUTHORIZED)
+	})
+
+	t.Run("Health is public", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodGet, "/health", nil, nil)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Read-only can read but not write", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodGet, "/team/get?team_name=auth-alpha", nil, apiKey("reader-key"))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = client.doAs(t, http.MethodPost, "/pullRequest/create", map[string]string{
+			"pull_request_id": "auth-pr-ro", "pull_request_name": "RO", "author_id": "auth-alpha-u1",
+		}, apiKey("reader-key"))
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+	})
+
+	t.Run("Member works with PRs but cannot manage teams", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodPost, "/pullRequest/create", map[string]string{
+			"pull_request_id": "auth-pr-1", "pull_request_name": "Feature", "author_id": "auth-alpha-u1",
+		}, apiKey("member-key"))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp = client.doAs(t, http.MethodPatch, "/teams/auth-alpha/deactivate-members",
+			map[string]any{"user_ids": []string{"auth-alpha-u3"}}, apiKey("member-key"))
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+	})
+
+	t.Run("Team lead is scoped to own team", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodPost, "/users/setIsActive",
+			map[string]any{"user_id": "auth-beta-u2", "is_active": false}, apiKey("lead-key"))
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+
+		resp = client.doAs(t, http.MethodPatch, "/teams/auth-beta/deactivate-members",
+			map[string]any{"user_ids": []string{"auth-beta-u3"}}, apiKey("lead-key"))
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+
+		resp = client.doAs(t, http.MethodPost, "/team/add", gen.Team{
+			TeamName: "auth-beta",
+			Members:  []gen.TeamMember{{UserId: "auth-beta-u4", Username: "Four", IsActive: true}},
+		}, apiKey("lead-key"))
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+
+		resp = client.doAs(t, http.MethodPost, "/users/setIsActive",
+			map[string]any{"user_id": "auth-alpha-u2", "is_active": false}, apiKey("lead-key"))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = client.doAs(t, http.MethodPatch, "/teams/auth-alpha/deactivate-members",
+			map[string]any{"user_ids": []string{"auth-alpha-u3"}}, apiKey("lead-key"))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("JWT", func(t *testing.T) {
+		tok := issuer.token(t, issuer.key, testIssuer, time.Hour, entity.RoleReadOnly, "")
+		resp := client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, bearer(tok))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = client.doAs(t, http.MethodPost, "/pullRequest/merge",
+			map[string]string{"pull_request_id": "auth-pr-1"}, bearer(tok))
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+
+		lead := issuer.token(t, issuer.key, testIssuer, time.Hour, entity.RoleTeamLead, "auth-beta")
+		resp = client.doAs(t, http.MethodPost, "/users/setIsActive",
+			map[string]any{"user_id": "auth-beta-u1", "is_active": true}, bearer(lead))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		expired := issuer.token(t, issuer.key, testIssuer, -time.Hour, entity.RoleAdmin, "")
+		resp = client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, bearer(expired))
+		requireErrorCode(t, resp, http.StatusUnauthorized, gen.UNAUTHORIZED)
+
+		wrongIssuer := issuer.token(t, issuer.key, "someone-else", time.Hour, entity.RoleAdmin, "")
+		resp = client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, bearer(wrongIssuer))
+		requireErrorCode(t, resp, http.StatusUnauthorized, gen.UNAUTHORIZED)
+
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		forged := issuer.token(t, otherKey, testIssuer, time.Hour, entity.RoleAdmin, "")
+		resp = client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, bearer(forged))
+		requireErrorCode(t, resp, http.StatusUnauthorized, gen.UNAUTHORIZED)
+	})
+
+	t.Run("gRPC", func(t *testing.T) {
+		grpcClient := newGRPCClientWith(t, db, authn)
+		withKey := func(key string) context.Context {
+			return metadata.AppendToOutgoingContext(ctx, "x-api-key", key)
+		}
+
+		_, err := grpcClient.GetPullRequestStats(ctx, &pb.GetPullRequestStatsRequest{})
+		requireCode(t, err, codes.Unauthenticated)
+
+		_, err = grpcClient.GetPullRequestStats(withKey("reader-key"), &pb.GetPullRequestStatsRequest{})
+		require.NoError(t, err)
+
+		_, err = grpcClient.DeactivateTeamMembers(withKey("reader-key"), &pb.DeactivateTeamMembersRequest{
+			TeamName: "auth-alpha", UserIds: []string{"auth-alpha-u1"},
+		})
+		requireCode(t, err, codes.PermissionDenied)
+
+		_, err = grpcClient.SetUserActive(withKey("lead-key"), &pb.SetUserActiveRequest{UserId: "auth-beta-u1"})
+		requireCode(t, err, codes.PermissionDenied)
+
+		_, err = grpcClient.SetUserActive(withKey(adminAPIKey), &pb.SetUserActiveRequest{UserId: "auth-beta-u1", IsActive: true})
+		require.NoError(t, err)
+	})
+}
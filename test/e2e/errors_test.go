@@ -0,0 +1,114 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponses(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	t.Run("Request ID is generated and returned in error", func(t *testing.T) {
+		resp := client.get(t, "/team/get?team_name=missing")
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		reqID := resp.Header.Get("X-Request-ID")
+		require.NotEmpty(t, reqID)
+
+		var body gen.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, gen.NOTFOUND, body.Error.Code)
+		require.NotNil(t, body.Error.RequestId)
+		assert.Equal(t, reqID, *body.Error.RequestId)
+	})
+
+	t.Run("Client request ID is preserved", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, client.baseURL+"/users/stats?user_id=missing", nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Request-ID", "client-req-42")
+
+		resp, err := client.client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, "client-req-42", resp.Header.Get("X-Request-ID"))
+		var body gen.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.NotNil(t, body.Error.RequestId)
+		assert.Equal(t, "client-req-42", *body.Error.RequestId)
+	})
+
+	t.Run("Problem details on request", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, client.baseURL+"/team/get?team_name=missing", nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/problem+json")
+
+		resp, err := client.client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "application/problem+json", resp.Header.Get("Content-Type"))
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		assert.Equal(t, "urn:pr-reviewer:error:not_found", problem.Type)
+		assert.Equal(t, "Not Found", problem.Title)
+		assert.Equal(t, http.StatusNotFound, problem.Status)
+		assert.Equal(t, gen.NOTFOUND, problem.Code)
+		require.NotNil(t, problem.Instance)
+		assert.Equal(t, "/team/get", *problem.Instance)
+		require.NotNil(t, problem.RequestId)
+		assert.Equal(t, resp.Header.Get("X-Request-ID"), *problem.RequestId)
+	})
+
+	t.Run("Validation problem lists fields", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, client.baseURL+"/users/stats", nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/problem+json, application/json;q=0.5")
+
+		resp, err := client.client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		assert.Equal(t, gen.VALIDATIONERROR, problem.Code)
+		require.NotNil(t, problem.Errors)
+		assert.Equal(t, "user_id", (*problem.Errors)[0].Field)
+	})
+
+	t.Run("Internal errors do not leak details", func(t *testing.T) {
+		closed, err := sql.Open("postgres", dbURL)
+		require.NoError(t, err)
+		require.NoError(t, closed.Close())
+
+		broken := newTestClientWith(closed, auth.Disabled(pg.NewTenantStorage(closed)))
+		t.Cleanup(broken.Close)
+
+		resp := broken.get(t, "/pullRequest/stats")
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		var body gen.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, gen.INTERNAL, body.Error.Code)
+		assert.Equal(t, "internal server error", body.Error.Message)
+		assert.Nil(t, body.Error.Details)
+	})
+}
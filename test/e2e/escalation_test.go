@@ -0,0 +1,179 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+func TestEscalation(t *testing.T) {
+	db := setupTestDB(t)
+	svc := newDeactivationService(db)
+	ctx := context.Background()
+
+	for _, team := range []entity.Team{
+		{Name: "es-a", Members: []entity.User{
+			{ID: "esa1", Username: "Author", IsActive: true},
+			{ID: "esa2", Username: "Bob", IsActive: true},
+		}},
+		{Name: "es-leads", Members: []entity.User{
+			{ID: "esl1", Username: "Lead", IsActive: true},
+		}},
+		{Name: "es-b", Members: []entity.User{
+			{ID: "esb1", Username: "Carol", IsActive: true},
+			{ID: "esb2", Username: "Dave", IsActive: true},
+		}},
+	} {
+		_, err := svc.AddOrUpdateTeam(ctx, team)
+		require.NoError(t, err)
+	}
+	_, err := svc.SetTeamEscalation(ctx, entity.EscalationPolicy{TeamName: "es-a", LeadUserID: "esl1", EscalationTeam: "es-b"})
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePR(ctx, "es-pr-1", "PR", "esa1", entity.NoCandidateFail)
+	require.NoError(t, err)
+	require.Equal(t, []string{"esa2"}, pr.Reviewers)
+
+	t.Run("Deactivation escalates to lead", func(t *testing.T) {
+		require.NoError(t, svc.DeactivateUsersAndReassign(ctx, "es-a", []string{"esa2"}, 0))
+		pr, err := svc.GetPR(ctx, "es-pr-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"esl1"}, pr.Reviewers)
+	})
+
+	t.Run("Create escalates to lead", func(t *testing.T) {
+		pr, err := svc.CreatePR(ctx, "es-pr-2", "PR", "esa1", entity.NoCandidateFail)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"esl1"}, pr.Reviewers)
+	})
+
+	t.Run("Inactive lead is skipped", func(t *testing.T) {
+		_, err := svc.SetUserActive(ctx, "esl1", false)
+		require.NoError(t, err)
+		pr, err := svc.CreatePR(ctx, "es-pr-3", "PR", "esa1", entity.NoCandidateFail)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"esb1", "esb2"}, pr.Reviewers)
+	})
+
+	t.Run("Reassign in NO_CANDIDATE mode", func(t *testing.T) {
+		// У es-leads ни кандидатов, ни правил эскалации
+		_, _, err := svc.ReassignReviewer(ctx, "es-pr-1", "esl1", 0, entity.NoCandidateFail)
+		require.ErrorIs(t, err, usecase.ErrNoCandidates)
+		pr, err := svc.GetPR(ctx, "es-pr-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"esl1"}, pr.Reviewers)
+
+		pr, replacedBy, err := svc.ReassignReviewer(ctx, "es-pr-1", "esl1", 0, entity.NoCandidateEmpty)
+		require.NoError(t, err)
+		assert.Empty(t, replacedBy)
+		assert.Empty(t, pr.Reviewers)
+	})
+
+	t.Run("Create in NO_CANDIDATE mode", func(t *testing.T) {
+		_, err := svc.SetTeamEscalation(ctx, entity.EscalationPolicy{TeamName: "es-a"})
+		require.NoError(t, err)
+
+		_, err = svc.CreatePR(ctx, "es-pr-4", "PR", "esa1", entity.NoCandidateFail)
+		require.ErrorIs(t, err, usecase.ErrNoCandidates)
+		_, err = svc.GetPR(ctx, "es-pr-4")
+		require.ErrorIs(t, err, usecase.ErrPRNotFound)
+
+		pr, err := svc.CreatePR(ctx, "es-pr-4", "PR", "esa1", entity.NoCandidateEmpty)
+		require.NoError(t, err)
+		assert.Empty(t, pr.Reviewers)
+	})
+}
+
+func TestTeamEscalationAPI(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	for _, team := range []map[string]any{
+		{"team_name": "ea-a", "members": []map[string]any{{"user_id": "ea-a1", "username": "A1", "is_active": true}}},
+		{"team_name": "ea-b", "members": []map[string]any{{"user_id": "ea-b1", "username": "B1", "is_active": true}}},
+	} {
+		resp := client.post(t, "/team/add", team)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	get := func(t *testing.T) gen.TeamEscalation {
+		resp := client.get(t, "/team/escalation?team_name=ea-a")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var e gen.TeamEscalation
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&e))
+		return e
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		assert.Equal(t, gen.TeamEscalation{TeamName: "ea-a"}, get(t))
+	})
+
+	t.Run("NO_CANDIDATE on create", func(t *testing.T) {
+		resp := client.post(t, "/pullRequest/create", map[string]any{
+			"pull_request_id": "ea-pr-1", "pull_request_name": "PR", "author_id": "ea-a1", "on_no_candidate": "NO_CANDIDATE",
+		})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		var body gen.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, gen.NOCANDIDATE, body.Error.Code)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		resp := client.post(t, "/team/escalation", map[string]any{"team_name": "ea-a", "escalation_team": "ea-b"})
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		e := get(t)
+		assert.Nil(t, e.LeadUserId)
+		require.NotNil(t, e.EscalationTeam)
+		assert.Equal(t, "ea-b", *e.EscalationTeam)
+
+		resp = client.post(t, "/pullRequest/create", map[string]any{
+			"pull_request_id": "ea-pr-1", "pull_request_name": "PR", "author_id": "ea-a1", "on_no_candidate": "NO_CANDIDATE",
+		})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var body struct {
+			PR gen.PullRequest `json:"pr"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, []string{"ea-b1"}, body.PR.AssignedReviewers)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			path   string
+			body   map[string]any
+			status int
+		}{
+			"self escalation":  {"/team/escalation", map[string]any{"team_name": "ea-a", "escalation_team": "ea-a"}, http.StatusBadRequest},
+			"unknown lead":     {"/team/escalation", map[string]any{"team_name": "ea-a", "lead_user_id": "no-such-user"}, http.StatusNotFound},
+			"unknown team":     {"/team/escalation", map[string]any{"team_name": "no-such-team"}, http.StatusNotFound},
+			"unknown esc team": {"/team/escalation", map[string]any{"team_name": "ea-a", "escalation_team": "no-such-team"}, http.StatusNotFound},
+			"unknown mode": {"/pullRequest/create", map[string]any{
+				"pull_request_id": "ea-pr-2", "pull_request_name": "PR", "author_id": "ea-a1", "on_no_candidate": "SKIP",
+			}, http.StatusBadRequest},
+		} {
+			t.Run(name, func(t *testing.T) {
+				resp := client.post(t, tc.path, tc.body)
+				resp.Body.Close()
+				assert.Equal(t, tc.status, resp.StatusCode)
+			})
+		}
+	})
+}
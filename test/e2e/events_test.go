@@ -0,0 +1,197 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sseEvent struct {
+	ID   int64
+	Type string
+	Data struct {
+		ID            int64  `json:"id"`
+		Type          string `json:"type"`
+		PullRequestID string `json:"pull_request_id"`
+		AuthorID      string `json:"author_id"`
+		TeamName      string `json:"team_name"`
+		ReviewerID    string `json:"reviewer_id"`
+	}
+}
+
+// openStream подключается к /events/stream и возвращает канал разобранных событий
+func (c *testClient) openStream(t *testing.T, query, lastEventID string) <-chan sseEvent {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/events/stream"+query, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := c.client.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	out := make(chan sseEvent, 64)
+	go func() {
+		defer close(out)
+		defer resp.Body.Close()
+
+		var cur sseEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if cur.ID != 0 {
+					out <- cur
+				}
+				cur = sseEvent{}
+			case strings.HasPrefix(line, "id: "):
+				cur.ID, _ = strconv.ParseInt(strings.TrimPrefix(line, "id: "), 10, 64)
+			case strings.HasPrefix(line, "event: "):
+				cur.Type = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				_ = json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &cur.Data)
+			}
+		}
+	}()
+
+	return out
+}
+
+func readEvents(t *testing.T, ch <-chan sseEvent, n int) []sseEvent {
+	t.Helper()
+
+	var got []sseEvent
+	timeout := time.After(5 * time.Second)
+	for len(got) < n {
+		select {
+		case e, ok := <-ch:
+			require.True(t, ok, "stream closed after %d events", len(got))
+			got = append(got, e)
+		case <-timeout:
+			t.Fatalf("expected %d events, got %d", n, len(got))
+		}
+	}
+	return got
+}
+
+func TestEventsStream(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	team := gen.Team{
+		TeamName: "events-team",
+		Members: []gen.TeamMember{
+			{UserId: "ev-u1", Username: "Alice", IsActive: true},
+			{UserId: "ev-u2", Username: "Bob", IsActive: true},
+			{UserId: "ev-u3", Username: "Charlie", IsActive: true},
+		},
+	}
+	resp := client.post(t, "/team/add", team)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	other := gen.Team{
+		TeamName: "events-other",
+		Members: []gen.TeamMember{
+			{UserId: "ev-o1", Username: "Dan", IsActive: true},
+			{UserId: "ev-o2", Username: "Eve", IsActive: true},
+		},
+	}
+	resp = client.post(t, "/team/add", other)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var created []sseEvent
+
+	t.Run("Live events are filtered by team", func(t *testing.T) {
+		stream := client.openStream(t, "?team_name=events-team", "")
+
+		// Событие чужой команды не должно попасть в поток
+		resp := client.post(t, "/pullRequest/create", map[string]string{
+			"pull_request_id":   "ev-pr-other",
+			"pull_request_name": "Other",
+			"author_id":         "ev-o1",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp = client.post(t, "/pullRequest/create", map[string]string{
+			"pull_request_id":   "ev-pr-1",
+			"pull_request_name": "Feature",
+			"author_id":         "ev-u1",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		created = readEvents(t, stream, 3)
+		assert.Equal(t, "PR_CREATED", created[0].Type)
+		for _, e := range created {
+			assert.Equal(t, "ev-pr-1", e.Data.PullRequestID)
+			assert.Equal(t, "events-team", e.Data.TeamName)
+			assert.Equal(t, e.ID, e.Data.ID)
+		}
+		assert.Equal(t, "REVIEWER_ASSIGNED", created[1].Type)
+		assert.Equal(t, "REVIEWER_ASSIGNED", created[2].Type)
+		assert.Less(t, created[0].ID, created[1].ID)
+		assert.Less(t, created[1].ID, created[2].ID)
+	})
+
+	t.Run("Resume with Last-Event-ID", func(t *testing.T) {
+		require.Len(t, created, 3)
+
+		stream := client.openStream(t, "?team_name=events-team", strconv.FormatInt(created[0].ID, 10))
+		replayed := readEvents(t, stream, 2)
+		assert.Equal(t, created[1].ID, replayed[0].ID)
+		assert.Equal(t, created[2].ID, replayed[1].ID)
+	})
+
+	t.Run("Reassign and merge events filtered by user", func(t *testing.T) {
+		require.Len(t, created, 3)
+		oldReviewer := created[1].Data.ReviewerID
+
+		stream := client.openStream(t, "?user_id="+oldReviewer, strconv.FormatInt(created[2].ID, 10))
+
+		resp := client.post(t, "/pullRequest/reassign", map[string]string{
+			"pull_request_id": "ev-pr-1",
+			"old_user_id":     oldReviewer,
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		got := readEvents(t, stream, 1)
+		assert.Equal(t, "REVIEWER_UNASSIGNED", got[0].Type)
+		assert.Equal(t, oldReviewer, got[0].Data.ReviewerID)
+
+		resp = client.post(t, "/pullRequest/merge", map[string]string{"pull_request_id": "ev-pr-1"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		teamStream := client.openStream(t, "?team_name=events-team", strconv.FormatInt(got[0].ID, 10))
+		all := readEvents(t, teamStream, 2)
+		assert.Equal(t, "PR_MERGED", all[len(all)-1].Type)
+	})
+
+	t.Run("Invalid Last-Event-ID", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, client.baseURL+"/events/stream", nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "abc")
+
+		resp, err := client.client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
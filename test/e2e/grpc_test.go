@@ -0,0 +1,255 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	grpctransport "github.com/mark47B/be-internship/internal/infra/transport/grpc"
+	"github.com/mark47B/be-internship/internal/infra/transport/grpc/pb"
+)
+
+// newGRPCClient поднимает gRPC-сервер поверх bufconn и возвращает клиента к нему
+func newGRPCClient(t *testing.T, db *sql.DB) pb.ReviewerServiceClient {
+	return newGRPCClientWith(t, db, auth.Disabled(pg.NewTenantStorage(db)))
+}
+
+func newGRPCClientWith(t *testing.T, db *sql.DB, authn *auth.Authenticator) pb.ReviewerServiceClient {
+	svc := app.NewService(
+		pg.NewTeamStorage(db),
+		pg.NewUserStorage(db),
+		pg.NewPullRequestStorage(db),
+		pg.NewEventStorage(db),
+		pg.NewStatsStorage(db),
+		pg.NewTxManager(db),
+		events.NewBroker(),
+		metrics.New(nil),
+		app.Options{},
+	)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpctransport.NewServer(svc, authn)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewReviewerServiceClient(conn)
+}
+
+func requireCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a gRPC status: %v", err)
+	assert.Equal(t, code, st.Code(), st.Message())
+}
+
+func TestGRPCAPI(t *testing.T) {
+	db := setupTestDB(t)
+	client := newGRPCClient(t, db)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	team := &pb.Team{
+		TeamName: "grpc-team",
+		Members: []*pb.TeamMember{
+			{UserId: "g1", Username: "Alice", IsActive: true},
+			{UserId: "g2", Username: "Bob", IsActive: true},
+			{UserId: "g3", Username: "Charlie", IsActive: true},
+			{UserId: "g4", Username: "Dave", IsActive: true},
+		},
+	}
+
+	t.Run("Teams", func(t *testing.T) {
+		resp, err := client.AddTeam(ctx, &pb.AddTeamRequest{Team: team})
+		require.NoError(t, err)
+		assert.Equal(t, "grpc-team", resp.GetTeam().GetTeamName())
+		assert.Len(t, resp.GetTeam().GetMembers(), 4)
+
+		_, err = client.AddTeam(ctx, &pb.AddTeamRequest{Team: team})
+		requireCode(t, err, codes.AlreadyExists)
+
+		got, err := client.GetTeam(ctx, &pb.GetTeamRequest{TeamName: "grpc-team"})
+		require.NoError(t, err)
+		assert.Len(t, got.GetTeam().GetMembers(), 4)
+
+		_, err = client.GetTeam(ctx, &pb.GetTeamRequest{TeamName: "missing"})
+		requireCode(t, err, codes.NotFound)
+
+		_, err = client.GetTeam(ctx, &pb.GetTeamRequest{})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("Pull requests", func(t *testing.T) {
+		created, err := client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+			PullRequestId:   "gpr-1",
+			PullRequestName: "Feature",
+			AuthorId:        "g1",
+		})
+		require.NoError(t, err)
+		pr := created.GetPr()
+		assert.Equal(t, pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN, pr.GetStatus())
+		assert.Len(t, pr.GetAssignedReviewers(), 2)
+		assert.NotContains(t, pr.GetAssignedReviewers(), "g1")
+		assert.NotNil(t, pr.GetCreatedAt())
+
+		_, err = client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+			PullRequestId: "gpr-1", PullRequestName: "Feature", AuthorId: "g1",
+		})
+		requireCode(t, err, codes.AlreadyExists)
+
+		_, err = client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+			PullRequestId: "gpr-2", PullRequestName: "Feature", AuthorId: "nobody",
+		})
+		requireCode(t, err, codes.NotFound)
+
+		reviews, err := client.GetUserReviews(ctx, &pb.GetUserReviewsRequest{UserId: pr.GetAssignedReviewers()[0]})
+		require.NoError(t, err)
+		require.Len(t, reviews.GetPullRequests(), 1)
+		assert.Equal(t, "gpr-1", reviews.GetPullRequests()[0].GetPullRequestId())
+
+		_, err = client.ReassignReviewer(ctx, &pb.ReassignReviewerRequest{PullRequestId: "gpr-1", OldUserId: "g1"})
+		requireCode(t, err, codes.FailedPrecondition)
+
+		// Версия из ответа — аналог ETag: устаревшая отклоняется, ничего не меняя
+		require.Positive(t, pr.GetVersion())
+		_, err = client.ReassignReviewer(ctx, &pb.ReassignReviewerRequest{
+			PullRequestId: "gpr-1", OldUserId: pr.GetAssignedReviewers()[0], ExpectedVersion: pr.GetVersion() + 1,
+		})
+		requireCode(t, err, codes.FailedPrecondition)
+		_, err = client.MergePullRequest(ctx, &pb.MergePullRequestRequest{PullRequestId: "gpr-1", ExpectedVersion: pr.GetVersion() + 1})
+		requireCode(t, err, codes.FailedPrecondition)
+
+		merged, err := client.MergePullRequest(ctx, &pb.MergePullRequestRequest{PullRequestId: "gpr-1", ExpectedVersion: pr.GetVersion()})
+		require.NoError(t, err)
+		assert.Greater(t, merged.GetPr().GetVersion(), pr.GetVersion())
+		assert.Equal(t, pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED, merged.GetPr().GetStatus())
+		assert.NotNil(t, merged.GetPr().GetMergedAt())
+
+		_, err = client.ReassignReviewer(ctx, &pb.ReassignReviewerRequest{
+			PullRequestId: "gpr-1", OldUserId: pr.GetAssignedReviewers()[0],
+		})
+		requireCode(t, err, codes.FailedPrecondition)
+
+		stats, err := client.GetPullRequestStats(ctx, &pb.GetPullRequestStatsRequest{})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, stats.GetStats().GetTotal())
+		assert.EqualValues(t, 1, stats.GetStats().GetMerged())
+	})
+
+	t.Run("Users", func(t *testing.T) {
+		resp, err := client.SetUserActive(ctx, &pb.SetUserActiveRequest{UserId: "g4", IsActive: false})
+		require.NoError(t, err)
+		assert.False(t, resp.GetUser().GetIsActive())
+
+		_, err = client.SetUserActive(ctx, &pb.SetUserActiveRequest{UserId: "nobody"})
+		requireCode(t, err, codes.NotFound)
+
+		stats, err := client.GetUserStats(ctx, &pb.GetUserStatsRequest{UserId: "g1"})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, stats.GetStats().GetCreatedPrCount())
+		assert.EqualValues(t, 1, stats.GetStats().GetMergedPrCount())
+	})
+
+	t.Run("Deactivation", func(t *testing.T) {
+		_, err := client.DeactivateTeamMembers(ctx, &pb.DeactivateTeamMembersRequest{
+			TeamName: "missing", UserIds: []string{"g2"},
+		})
+		requireCode(t, err, codes.NotFound)
+
+		_, err = client.DeactivateTeamMembers(ctx, &pb.DeactivateTeamMembersRequest{
+			TeamName: "grpc-team", UserIds: []string{"nobody"},
+		})
+		requireCode(t, err, codes.InvalidArgument)
+
+		_, err = client.DeactivateTeamMembers(ctx, &pb.DeactivateTeamMembersRequest{
+			TeamName: "grpc-team", UserIds: []string{"g2"},
+		})
+		require.NoError(t, err)
+
+		got, err := client.GetTeam(ctx, &pb.GetTeamRequest{TeamName: "grpc-team"})
+		require.NoError(t, err)
+		for _, m := range got.GetTeam().GetMembers() {
+			if m.GetUserId() == "g2" {
+				assert.False(t, m.GetIsActive())
+			}
+		}
+	})
+
+	t.Run("Stream events", func(t *testing.T) {
+		streamCtx, streamCancel := context.WithCancel(ctx)
+		defer streamCancel()
+
+		stream, err := client.StreamEvents(streamCtx, &pb.StreamEventsRequest{TeamName: "grpc-team"})
+		require.NoError(t, err)
+
+		// Журнал: PR_CREATED, 2×REVIEWER_ASSIGNED, PR_MERGED
+		first, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, pb.EventType_EVENT_TYPE_PR_CREATED, first.GetType())
+		assert.Equal(t, "gpr-1", first.GetPullRequestId())
+
+		for i := 0; i < 3; i++ {
+			_, err := stream.Recv()
+			require.NoError(t, err)
+		}
+
+		_, err = client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+			PullRequestId: "gpr-3", PullRequestName: "Live", AuthorId: "g1",
+		})
+		require.NoError(t, err)
+
+		live, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, pb.EventType_EVENT_TYPE_PR_CREATED, live.GetType())
+		assert.Equal(t, "gpr-3", live.GetPullRequestId())
+		assert.Greater(t, live.GetId(), first.GetId())
+	})
+	t.Run("No candidate mode", func(t *testing.T) {
+		_, err := client.AddTeam(ctx, &pb.AddTeamRequest{Team: &pb.Team{
+			TeamName: "grpc-solo",
+			Members:  []*pb.TeamMember{{UserId: "gs1", Username: "Solo", IsActive: true}},
+		}})
+		require.NoError(t, err)
+
+		_, err = client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+			PullRequestId: "gpr-solo", PullRequestName: "Alone", AuthorId: "gs1",
+			NoCandidateMode: pb.NoCandidateMode_NO_CANDIDATE_MODE_FAIL,
+		})
+		requireCode(t, err, codes.FailedPrecondition)
+
+		// По умолчанию — как EMPTY: PR создаётся без ревьюверов
+		created, err := client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+			PullRequestId: "gpr-solo", PullRequestName: "Alone", AuthorId: "gs1",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, created.GetPr().GetAssignedReviewers())
+	})
+}
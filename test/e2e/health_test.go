@@ -0,0 +1,71 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/database/migrations"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// schemaVersion — версия схемы, которую ждёт бинарник; TestMain применяет те же миграции
+func schemaVersion() uint {
+	v, err := migrations.LatestVersion()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestHealthProbes(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	ready := func(t *testing.T, wantStatus int) gen.HealthReport {
+		resp := client.doAs(t, http.MethodGet, "/health/ready", nil, nil)
+		defer resp.Body.Close()
+		require.Equal(t, wantStatus, resp.StatusCode)
+
+		var report gen.HealthReport
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+		return report
+	}
+
+	t.Run("Liveness needs no credentials", func(t *testing.T) {
+		resp, err := http.Get(client.baseURL + "/health/live")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+	})
+
+	t.Run("Ready when database and schema are up to date", func(t *testing.T) {
+		report := ready(t, http.StatusOK)
+		assert.Equal(t, gen.HealthReportStatusOk, report.Status)
+		for _, name := range []string{"database", "migrations", "server"} {
+			require.Contains(t, report.Components, name)
+			assert.Equal(t, gen.HealthComponentStatusOk, report.Components[name].Status, name)
+		}
+	})
+
+	t.Run("Not ready after shutdown started", func(t *testing.T) {
+		client.health.Shutdown()
+
+		report := ready(t, http.StatusServiceUnavailable)
+		assert.Equal(t, gen.HealthReportStatusFail, report.Status)
+		assert.Equal(t, gen.HealthComponentStatusFail, report.Components["server"].Status)
+		assert.Equal(t, gen.HealthComponentStatusOk, report.Components["database"].Status)
+
+		// Liveness не зависит от остановки: процесс жив, пока дорабатывает запросы
+		resp := client.doAs(t, http.MethodGet, "/health/live", nil, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+	})
+}
@@ -0,0 +1,167 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+func TestTeamHierarchy(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	// h-org → h-dept → h-api, h-web
+	for team, members := range map[string][]string{
+		"h-org":  {"h-o1"},
+		"h-dept": {"h-d1"},
+		"h-api":  {"h-a1", "h-a2"},
+		"h-web":  {"h-w1"},
+	} {
+		body := gen.Team{TeamName: team}
+		for _, id := range members {
+			body.Members = append(body.Members, gen.TeamMember{UserId: id, Username: id, IsActive: true})
+		}
+		resp := client.post(t, "/team/add", body)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	for _, move := range [][2]string{{"h-dept", "h-org"}, {"h-api", "h-dept"}, {"h-web", "h-dept"}} {
+		resp := client.post(t, "/team/parent", map[string]any{"team_name": move[0], "parent_team": move[1]})
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	resp := client.get(t, "/team/get?team_name=h-api")
+	var team gen.Team
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&team))
+	resp.Body.Close()
+	require.NotNil(t, team.ParentTeam)
+	assert.Equal(t, "h-dept", *team.ParentTeam)
+
+	createPR := func(t *testing.T, id, author string) gen.PullRequest {
+		resp := client.post(t, "/pullRequest/create", map[string]any{
+			"pull_request_id": id, "pull_request_name": "PR", "author_id": author, "on_no_candidate": "NO_CANDIDATE",
+		})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var body struct {
+			PR gen.PullRequest `json:"pr"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return body.PR
+	}
+
+	t.Run("Reviewer fallback to parent team", func(t *testing.T) {
+		assert.Equal(t, []string{"h-a2"}, createPR(t, "h-pr-1", "h-a1").AssignedReviewers)
+		// В h-web больше никого — ревьювер из ближайшего родителя
+		assert.Equal(t, []string{"h-d1"}, createPR(t, "h-pr-2", "h-w1").AssignedReviewers)
+	})
+
+	t.Run("Cycles are rejected", func(t *testing.T) {
+		for _, move := range [][2]string{{"h-org", "h-api"}, {"h-dept", "h-dept"}} {
+			resp := client.post(t, "/team/parent", map[string]any{"team_name": move[0], "parent_team": move[1]})
+			requireErrorCode(t, resp, http.StatusConflict, gen.CONFLICT)
+		}
+
+		resp := client.post(t, "/team/parent", map[string]any{"team_name": "h-org", "parent_team": "no-such-team"})
+		requireErrorCode(t, resp, http.StatusNotFound, gen.NOTFOUND)
+
+		// Триггер не пускает цикл и мимо сервиса
+		_, err := db.Exec(`UPDATE teams SET parent_team = 'h-web' WHERE name = 'h-org'`)
+		require.Error(t, err)
+	})
+
+	tree := func(t *testing.T, query string) []gen.TeamNode {
+		resp := client.get(t, "/team/tree"+query)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var body struct {
+			Teams []gen.TeamNode `json:"teams"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return body.Teams
+	}
+
+	t.Run("Subtree", func(t *testing.T) {
+		roots := tree(t, "?team_name=h-dept")
+		require.Len(t, roots, 1)
+		dept := roots[0]
+		assert.Equal(t, "h-dept", dept.TeamName)
+		assert.Equal(t, gen.TeamCounts{Members: 1, ActiveMembers: 1, OpenReviews: 1}, dept.Own)
+		assert.Equal(t, gen.TeamCounts{Members: 4, ActiveMembers: 4, OpenPrs: 2, OpenReviews: 2}, dept.Total)
+		require.Len(t, dept.Subteams, 2)
+		assert.Equal(t, "h-api", dept.Subteams[0].TeamName)
+		assert.Equal(t, "h-web", dept.Subteams[1].TeamName)
+		assert.Equal(t, gen.TeamCounts{Members: 1, ActiveMembers: 1, OpenPrs: 1}, dept.Subteams[1].Total)
+
+		roots = tree(t, "")
+		require.Len(t, roots, 1)
+		assert.Equal(t, "h-org", roots[0].TeamName)
+		assert.Equal(t, 5, roots[0].Total.Members)
+
+		resp := client.get(t, "/team/tree?team_name=no-such-team")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Rolled-up stats", func(t *testing.T) {
+		stats := func(t *testing.T, query string) gen.TeamStats {
+			resp := client.get(t, "/team/stats?team_name=h-dept"+query)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			var s gen.TeamStats
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&s))
+			return s
+		}
+
+		own := stats(t, "")
+		assert.False(t, own.IncludeSubteams)
+		assert.Equal(t, 0, own.PullRequests.Total)
+		assert.Len(t, own.Members, 1)
+
+		all := stats(t, "&include_subteams=true")
+		assert.True(t, all.IncludeSubteams)
+		assert.Equal(t, 2, all.PullRequests.Total)
+		require.Len(t, all.Members, 4)
+		teams := map[string]string{}
+		for _, m := range all.Members {
+			teams[m.UserId] = m.TeamName
+		}
+		assert.Equal(t, map[string]string{"h-d1": "h-dept", "h-a1": "h-api", "h-a2": "h-api", "h-w1": "h-web"}, teams)
+	})
+
+	t.Run("Team lead manages subteams", func(t *testing.T) {
+		_, err := auth.SaveAPIKey(context.Background(), pg.NewAPIKeyStorage(db), "h-lead", "h-lead-key", entity.RoleTeamLead, "h-dept")
+		require.NoError(t, err)
+		lead := map[string]string{"X-API-Key": "h-lead-key"}
+
+		resp := client.doAs(t, http.MethodPost, "/team/sla", map[string]any{"team_name": "h-api", "remind_after_hours": 24}, lead)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = client.doAs(t, http.MethodPost, "/team/sla", map[string]any{"team_name": "h-org", "remind_after_hours": 24}, lead)
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+
+		// Вывести свою команду из-под h-org нельзя: нужны права на текущего родителя
+		resp = client.doAs(t, http.MethodPost, "/team/parent", map[string]any{"team_name": "h-dept"}, lead)
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+
+		// Внутри своего поддерева — можно
+		resp = client.doAs(t, http.MethodPost, "/team/parent", map[string]any{"team_name": "h-web", "parent_team": "h-api"}, lead)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
@@ -0,0 +1,192 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// postWithKey отправляет POST с заголовком Idempotency-Key и возвращает статус, тело и флаг повтора
+func (c *testClient) postWithKey(t *testing.T, path, key string, body any) (int, []byte, bool) {
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+path, bytes.NewReader(b))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", key)
+
+	resp, err := c.client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, data, resp.Header.Get("Idempotent-Replayed") == "true"
+}
+
+func assignedReviewers(t *testing.T, db *sql.DB, prID string) []string {
+	rows, err := db.Query(`SELECT reviewer_id FROM review_assignments WHERE pr_id = $1 ORDER BY reviewer_id`, prID)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	return ids
+}
+
+func TestIdempotency(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	resp := client.post(t, "/team/add", gen.Team{
+		TeamName: "idem",
+		Members: []gen.TeamMember{
+			{UserId: "idem-a", Username: "A", IsActive: true},
+			{UserId: "idem-r1", Username: "R1", IsActive: true},
+			{UserId: "idem-r2", Username: "R2", IsActive: true},
+			{UserId: "idem-r3", Username: "R3", IsActive: true},
+			{UserId: "idem-r4", Username: "R4", IsActive: true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	createBody := map[string]any{
+		"pull_request_id":   "pr-idem",
+		"pull_request_name": "feat: idempotency",
+		"author_id":         "idem-a",
+	}
+
+	t.Run("Retry of create returns stored response", func(t *testing.T) {
+		status, first, replayed := client.postWithKey(t, "/pullRequest/create", "create-1", createBody)
+		require.Equal(t, http.StatusCreated, status)
+		assert.False(t, replayed)
+
+		status, second, replayed := client.postWithKey(t, "/pullRequest/create", "create-1", createBody)
+		require.Equal(t, http.StatusCreated, status, "retry must not fail with PR_EXISTS: %s", second)
+		assert.True(t, replayed)
+		assert.JSONEq(t, string(first), string(second))
+	})
+
+	t.Run("Same key with different body → 422", func(t *testing.T) {
+		other := map[string]any{
+			"pull_request_id":   "pr-idem-other",
+			"pull_request_name": "feat: other",
+			"author_id":         "idem-a",
+		}
+		status, body, _ := client.postWithKey(t, "/pullRequest/create", "create-1", other)
+		require.Equal(t, http.StatusUnprocessableEntity, status)
+
+		var errResp gen.ErrorResponse
+		require.NoError(t, json.Unmarshal(body, &errResp))
+		assert.Equal(t, gen.IDEMPOTENCYKEYREUSED, errResp.Error.Code)
+
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM pull_requests WHERE id = $1`, "pr-idem-other").Scan(&count))
+		assert.Zero(t, count)
+	})
+
+	t.Run("Retry of reassign does not reassign twice", func(t *testing.T) {
+		var reviewer string
+		require.NoError(t, db.QueryRow(
+			`SELECT reviewer_id FROM review_assignments WHERE pr_id = $1 ORDER BY reviewer_id LIMIT 1`, "pr-idem",
+		).Scan(&reviewer))
+
+		reassign := map[string]any{"pull_request_id": "pr-idem", "old_user_id": reviewer}
+		status, first, _ := client.postWithKey(t, "/pullRequest/reassign", "reassign-1", reassign)
+		require.Equal(t, http.StatusOK, status, string(first))
+
+		before := assignedReviewers(t, db, "pr-idem")
+
+		status, second, replayed := client.postWithKey(t, "/pullRequest/reassign", "reassign-1", reassign)
+		require.Equal(t, http.StatusOK, status, string(second))
+		assert.True(t, replayed)
+		assert.JSONEq(t, string(first), string(second))
+
+		assert.Equal(t, before, assignedReviewers(t, db, "pr-idem"))
+	})
+
+	t.Run("Errors are replayed too", func(t *testing.T) {
+		body := map[string]any{"pull_request_id": "pr-missing", "old_user_id": "idem-r1"}
+		status, first, _ := client.postWithKey(t, "/pullRequest/reassign", "missing-1", body)
+		require.Equal(t, http.StatusNotFound, status)
+
+		status, second, replayed := client.postWithKey(t, "/pullRequest/reassign", "missing-1", body)
+		require.Equal(t, http.StatusNotFound, status)
+		assert.True(t, replayed)
+		assert.JSONEq(t, string(first), string(second))
+	})
+
+	t.Run("Replay restores handler headers", func(t *testing.T) {
+		headers := map[string]string{"X-API-Key": adminAPIKey, "Idempotency-Key": "create-etag"}
+		body := map[string]any{"pull_request_id": "pr-idem-etag", "pull_request_name": "etag", "author_id": "idem-a"}
+
+		resp := client.doAs(t, http.MethodPost, "/pullRequest/create", body, headers)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		etag := resp.Header.Get("ETag")
+		require.NotEmpty(t, etag)
+
+		resp = client.doAs(t, http.MethodPost, "/pullRequest/create", body, headers)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "true", resp.Header.Get("Idempotent-Replayed"))
+		assert.Equal(t, etag, resp.Header.Get("ETag"))
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		// Идентификатор запроса — свой у каждого запроса, а не из сохранённого ответа
+		assert.NotEmpty(t, resp.Header.Get("X-Request-ID"))
+	})
+
+	t.Run("Abandoned key is reclaimed after lease", func(t *testing.T) {
+		body := map[string]any{"pull_request_id": "pr-idem-lease", "pull_request_name": "lease", "author_id": "idem-a"}
+		status, _, _ := client.postWithKey(t, "/pullRequest/create", "lease-1", body)
+		require.Equal(t, http.StatusCreated, status)
+
+		// Процесс «упал» между резервом и сохранением ответа: ответа нет, lease ещё действует
+		_, err := db.Exec(`
+			UPDATE idempotency_keys SET status_code = NULL, response_body = NULL, locked_until = now() + interval '1 hour'
+			WHERE key = 'lease-1'
+		`)
+		require.NoError(t, err)
+		status, _, _ = client.postWithKey(t, "/pullRequest/create", "lease-1", body)
+		require.Equal(t, http.StatusConflict, status)
+
+		// Lease истёк — ключ снова свободен, хотя до expires_at ещё далеко
+		_, err = db.Exec(`UPDATE idempotency_keys SET locked_until = now() - interval '1 second' WHERE key = 'lease-1'`)
+		require.NoError(t, err)
+		status, data, replayed := client.postWithKey(t, "/pullRequest/create", "lease-1", body)
+		assert.False(t, replayed)
+		// Запрос выполнился заново: PR уже есть, а не «ключ занят»
+		require.Equal(t, http.StatusConflict, status)
+		var errResp gen.ErrorResponse
+		require.NoError(t, json.Unmarshal(data, &errResp))
+		assert.Equal(t, gen.PREXISTS, errResp.Error.Code)
+	})
+
+	t.Run("Without key behaviour is unchanged", func(t *testing.T) {
+		resp := client.post(t, "/pullRequest/create", createBody)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		var errResp gen.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+		assert.Equal(t, gen.PREXISTS, errResp.Error.Code)
+	})
+}
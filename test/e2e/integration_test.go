@@ -6,38 +6,74 @@ package e2e
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/health"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
 	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/tracing"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
 	"github.com/mark47B/be-internship/internal/infra/transport/rest/handlers"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/middleware"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/requestid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 type testClient struct {
+	health  *health.Checker
 	server  *httptest.Server
 	client  *http.Client
 	baseURL string
 }
 
+// adminAPIKey — ключ, с которым testClient ходит по умолчанию
+const adminAPIKey = "e2e-admin-key"
+
 func newTestClient(db *sql.DB) *testClient {
-	teamRepo := pg.NewTeamStorage(db)
-	userRepo := pg.NewUserStorage(db)
-	prRepo := pg.NewPullRequestStorage(db)
-	txRepo := pg.NewTxManager(db)
+	apiKeyRepo := pg.NewAPIKeyStorage(db)
+	if _, err := auth.SaveAPIKey(context.Background(), apiKeyRepo, "e2e-admin", adminAPIKey, entity.RoleAdmin, ""); err != nil {
+		panic(err)
+	}
+	authn, err := auth.NewAuthenticator(apiKeyRepo, pg.NewTeamStorage(db), pg.NewTenantStorage(db), auth.Options{})
+	if err != nil {
+		panic(err)
+	}
 
-	svc := app.NewService(teamRepo, userRepo, prRepo, txRepo)
-	h := handlers.NewHandlers(svc)
+	return newTestClientWith(db, authn)
+}
+
+func newTestClientWith(db *sql.DB, authn *auth.Authenticator) *testClient {
+	teamRepo := tracing.InstrumentTeamRepository(pg.NewTeamStorage(db))
+	userRepo := tracing.InstrumentUserRepository(pg.NewUserStorage(db))
+	prRepo := tracing.InstrumentPullRequestRepository(pg.NewPullRequestStorage(db))
+	eventRepo := tracing.InstrumentEventRepository(pg.NewEventStorage(db))
+	statsRepo := tracing.InstrumentStatsRepository(pg.NewStatsStorage(db))
+	m := metrics.New(db)
+	txRepo := m.InstrumentTxManager(tracing.InstrumentTxManager(pg.NewTxManager(db)))
+
+	svc := tracing.InstrumentService(app.NewService(teamRepo, userRepo, prRepo, eventRepo, statsRepo, txRepo, events.NewBroker(), m, app.Options{}))
+	checker := health.NewChecker(db, schemaVersion(), time.Second)
+	h := handlers.NewHandlers(svc, checker)
 
 	router := chi.NewRouter()
+	router.Use(requestid.Middleware)
+	router.Use(middleware.Tracing)
+	router.Use(middleware.RequestLogger)
+	router.Use(middleware.Metrics(m))
+	router.Use(middleware.Recoverer)
 	router.Use(func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
@@ -45,20 +81,45 @@ func newTestClient(db *sql.DB) *testClient {
 		})
 	})
 
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		panic(err)
+	}
+	authMiddleware, err := middleware.Authenticate(swagger, authn)
+	if err != nil {
+		panic(err)
+	}
+	router.Use(authMiddleware)
+	validator, err := middleware.OpenAPIValidator(swagger, middleware.ValidatorOptions{ValidateResponses: true})
+	if err != nil {
+		panic(err)
+	}
+	router.Use(validator)
+	router.Use(middleware.Idempotency(pg.NewIdempotencyStorage(db), time.Hour, time.Minute))
+
 	gen.HandlerFromMux(h, router)
+	router.Handle("/metrics", m.Handler())
 	server := httptest.NewServer(router)
 
 	return &testClient{
+		health:  checker,
 		server:  server,
-		client:  http.DefaultClient,
+		client:  &http.Client{Transport: apiKeyTransport{key: adminAPIKey}},
 		baseURL: server.URL,
 	}
 }
 
-// getTestDB returns the test database connection
-func getTestDB(t *testing.T) *sql.DB {
-	db := setupTestDB(t)
-	return db
+// apiKeyTransport подставляет ключ, если запрос не несёт своих учётных данных
+type apiKeyTransport struct {
+	key string
+}
+
+func (t apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("X-API-Key") == "" && req.Header.Get("Authorization") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("X-API-Key", t.key)
+	}
+	return http.DefaultTransport.RoundTrip(req)
 }
 
 func (c *testClient) Close() {
@@ -0,0 +1,104 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/infra/logging"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// syncBuffer — в лог пишут и параллельные тесты пакета
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// linesWith возвращает JSON-строки лога с указанным request_id
+func (b *syncBuffer) linesWith(t *testing.T, requestID string) []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var lines []map[string]any
+	sc := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for sc.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &line))
+		if line["request_id"] == requestID {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestStructuredLogging(t *testing.T) {
+	var out syncBuffer
+	prev := slog.Default()
+	logging.Setup(&out, slog.LevelDebug)
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	withRequestID := func(id string) map[string]string {
+		return map[string]string{"X-API-Key": adminAPIKey, "X-Request-ID": id}
+	}
+
+	resp := client.post(t, "/team/add", gen.Team{
+		TeamName: "logging",
+		Members:  []gen.TeamMember{{UserId: "log-a", Username: "A", IsActive: true}},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	t.Run("Request line has route, status, latency and user", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodGet, "/team/get?team_name=logging", nil, withRequestID("log-req-1"))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "log-req-1", resp.Header.Get("X-Request-ID"))
+		resp.Body.Close()
+
+		lines := out.linesWith(t, "log-req-1")
+		require.Len(t, lines, 1)
+		line := lines[0]
+		assert.Equal(t, "http request", line["msg"])
+		assert.Equal(t, "/team/get", line["route"])
+		assert.EqualValues(t, http.StatusOK, line["status"])
+		assert.Equal(t, "apikey:e2e-admin", line["user"])
+		assert.Contains(t, line, "latency_ms")
+	})
+
+	t.Run("Service logs share the request id", func(t *testing.T) {
+		// Автор — единственный в команде, кандидатов нет: сервис пишет предупреждение
+		resp := client.doAs(t, http.MethodPost, "/pullRequest/create", map[string]any{
+			"pull_request_id": "pr-log", "pull_request_name": "log", "author_id": "log-a",
+		}, withRequestID("log-req-2"))
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		resp.Body.Close()
+
+		var messages []string
+		for _, line := range out.linesWith(t, "log-req-2") {
+			messages = append(messages, line["msg"].(string))
+			assert.Equal(t, "apikey:e2e-admin", line["user"])
+		}
+		assert.Contains(t, messages, "no reviewer candidates for PR")
+		assert.Contains(t, messages, "http request")
+	})
+}
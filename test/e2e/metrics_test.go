@@ -0,0 +1,87 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	scrape := func(t *testing.T) string {
+		resp, err := http.Get(client.baseURL + "/metrics")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	resp := client.post(t, "/team/add", gen.Team{
+		TeamName: "metrics",
+		Members: []gen.TeamMember{
+			{UserId: "m-a", Username: "A", IsActive: true},
+			{UserId: "m-r1", Username: "R1", IsActive: true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = client.post(t, "/team/add", gen.Team{
+		TeamName: "metrics-solo",
+		Members:  []gen.TeamMember{{UserId: "m-solo", Username: "Solo", IsActive: true}},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	for _, pr := range []map[string]any{
+		{"pull_request_id": "pr-m1", "pull_request_name": "m1", "author_id": "m-a"},
+		{"pull_request_id": "pr-m2", "pull_request_name": "m2", "author_id": "m-solo"},
+	} {
+		resp := client.post(t, "/pullRequest/create", pr)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	// Повторный merge идемпотентен и не должен считаться второй раз
+	for range 2 {
+		resp := client.post(t, "/pullRequest/merge", map[string]any{"pull_request_id": "pr-m1"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	resp = client.get(t, "/team/get?team_name=missing")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+
+	body := scrape(t)
+
+	t.Run("HTTP requests by route pattern", func(t *testing.T) {
+		assert.Contains(t, body, `pr_reviewer_http_request_duration_seconds_count{method="POST",route="/pullRequest/create",status="201"} 2`)
+		assert.Contains(t, body, `pr_reviewer_http_request_duration_seconds_count{method="POST",route="/pullRequest/merge",status="200"} 2`)
+		assert.Contains(t, body, `pr_reviewer_http_request_duration_seconds_count{method="GET",route="/team/get",status="404"} 1`)
+	})
+
+	t.Run("Domain counters", func(t *testing.T) {
+		assert.Contains(t, body, "pr_reviewer_prs_created_total 2")
+		assert.Contains(t, body, "pr_reviewer_prs_merged_total 1")
+		assert.Contains(t, body, `pr_reviewer_reviewer_no_candidates_total{operation="create"} 1`)
+	})
+
+	t.Run("Transactions and pool stats", func(t *testing.T) {
+		assert.Contains(t, body, `pr_reviewer_db_transactions_total{result="commit"}`)
+		assert.Contains(t, body, `go_sql_max_open_connections{db_name="postgres"}`)
+	})
+}
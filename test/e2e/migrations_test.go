@@ -0,0 +1,65 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/database/migrations"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	t.Run("Up on an up-to-date schema is a no-op", func(t *testing.T) {
+		m, err := pg.NewMigrator(ctx, db, migrations.FS)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = m.Close() })
+
+		require.NoError(t, m.Up())
+
+		version, dirty, err := m.Version()
+		require.NoError(t, err)
+		assert.False(t, dirty)
+		assert.Equal(t, schemaVersion(), version)
+	})
+
+	t.Run("Concurrent replicas wait for the advisory lock", func(t *testing.T) {
+		errs := make([]error, 3)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				m, err := pg.NewMigrator(ctx, db, migrations.FS)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				defer m.Close()
+				errs[i] = m.Up()
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("Migrator leaves the pool open", func(t *testing.T) {
+		m, err := pg.NewMigrator(ctx, db, migrations.FS)
+		require.NoError(t, err)
+		require.NoError(t, m.Close())
+
+		assert.NoError(t, db.PingContext(ctx))
+	})
+}
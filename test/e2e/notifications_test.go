@@ -0,0 +1,328 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
+	"github.com/mark47B/be-internship/internal/infra/notify"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// payloads — уведомления, которые канал file записал в буфер
+func (b *syncBuffer) payloads(t *testing.T) []notify.WebhookPayload {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []notify.WebhookPayload
+	sc := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for sc.Scan() {
+		var p notify.WebhookPayload
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &p))
+		out = append(out, p)
+	}
+	return out
+}
+
+func TestAssignmentNotifications(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	broker := events.NewBroker()
+	t.Cleanup(broker.Close)
+	users := pg.NewUserStorage(db)
+	prs := pg.NewPullRequestStorage(db)
+	svc := app.NewService(pg.NewTeamStorage(db), users, prs, pg.NewEventStorage(db), pg.NewStatsStorage(db),
+		pg.NewTxManager(db), broker, metrics.New(nil), app.Options{})
+
+	var chatMu sync.Mutex
+	var chat []notify.ChatMessage
+	chatSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var m notify.ChatMessage
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		chatMu.Lock()
+		chat = append(chat, m)
+		chatMu.Unlock()
+	}))
+	t.Cleanup(chatSrv.Close)
+	chatMessages := func() []notify.ChatMessage {
+		chatMu.Lock()
+		defer chatMu.Unlock()
+		return append([]notify.ChatMessage(nil), chat...)
+	}
+
+	file := &syncBuffer{}
+	notifier := app.NewAssignmentNotifier(pg.NewTenantStorage(db), users, prs, pg.NewEventStorage(db), svc,
+		pg.NewLeaderLock(db, pg.NotifyLockKey), map[entity.NotificationChannel]usecase.Notifier{
+			entity.ChannelFile: notify.NewFile(file),
+			entity.ChannelChat: notify.NewChat(chatSrv.URL),
+		}, []entity.NotificationChannel{entity.ChannelFile})
+	go notifier.Run(ctx)
+
+	for _, team := range []entity.Team{
+		{Name: "nt-a", Members: []entity.User{
+			{ID: "nta1", Username: "Author", IsActive: true},
+			{ID: "nta2", Username: "Bob", IsActive: true},
+			{ID: "nta3", Username: "Carol", IsActive: true},
+		}},
+		{Name: "nt-b", Members: []entity.User{
+			{ID: "ntb1", Username: "Author", IsActive: true},
+			{ID: "ntb2", Username: "Dave", IsActive: true},
+			{ID: "ntb3", Username: "Erin", IsActive: true},
+			{ID: "ntb4", Username: "Frank", IsActive: false},
+		}},
+	} {
+		_, err := svc.AddOrUpdateTeam(ctx, team)
+		require.NoError(t, err)
+	}
+
+	// nta2 — только чат; у nta3 сейчас тихие часы; в nt-b настроек нет ни у кого — канал по умолчанию (file)
+	_, err := svc.SetNotificationPrefs(ctx, entity.NotificationPrefs{UserID: "nta2", Channels: []entity.NotificationChannel{entity.ChannelChat}})
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	_, err = svc.SetNotificationPrefs(ctx, entity.NotificationPrefs{
+		UserID:   "nta3",
+		Channels: []entity.NotificationChannel{entity.ChannelFile, entity.ChannelChat},
+		QuietHours: &entity.QuietHours{
+			Start:    time.Duration(now.Add(-time.Hour).Hour()) * time.Hour,
+			End:      time.Duration(now.Add(2*time.Hour).Hour()) * time.Hour,
+			Location: time.UTC,
+		},
+	})
+	require.NoError(t, err)
+
+	// Позиция рассылки в журнале должна появиться до первого события
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = svc.CreatePR(ctx, "nt-pr-1", "Add search", "nta1", entity.NoCandidateEmpty)
+	require.NoError(t, err)
+	_, err = svc.CreatePR(ctx, "nt-pr-2", "Fix login", "ntb1", entity.NoCandidateEmpty)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(chatMessages()) >= 1 && len(file.payloads(t)) >= 2
+	}, 5*time.Second, 50*time.Millisecond)
+	// Даём шанс прийти лишним уведомлениям
+	time.Sleep(200 * time.Millisecond)
+
+	msgs := chatMessages()
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0].Text, "nt-pr-1")
+	assert.Contains(t, msgs[0].Text, "Add search")
+	assert.True(t, strings.HasSuffix(msgs[0].Text, "For: nta2"), msgs[0].Text)
+
+	sent := file.payloads(t)
+	require.Len(t, sent, 2)
+	recipients := []string{}
+	for _, p := range sent {
+		assert.Equal(t, entity.NotificationReviewAssigned, p.Kind)
+		assert.Equal(t, "nt-pr-2", p.PRID)
+		assert.Equal(t, "Fix login", p.PRName)
+		assert.Equal(t, "nt-b", p.TeamName)
+		recipients = append(recipients, p.Recipients...)
+	}
+	assert.ElementsMatch(t, []string{"ntb2", "ntb3"}, recipients)
+
+	t.Run("Deactivation", func(t *testing.T) {
+		// Место ntb2 занимает ntb4, которого активировали уже после создания PR
+		_, err := svc.SetUserActive(ctx, "ntb4", true)
+		require.NoError(t, err)
+		require.NoError(t, svc.DeactivateUsersAndReassign(ctx, "nt-b", []string{"ntb2"}, 0))
+
+		require.Eventually(t, func() bool { return len(file.payloads(t)) == 3 }, 5*time.Second, 50*time.Millisecond)
+		last := file.payloads(t)[2]
+		assert.Equal(t, "nt-pr-2", last.PRID)
+		assert.Equal(t, []string{"ntb4"}, last.Recipients)
+	})
+}
+
+func TestAssignmentNotificationsAcrossTenants(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	tenants := pg.NewTenantStorage(db)
+	for _, id := range []string{"acme", "globex"} {
+		_, err := tenants.Create(ctx, entity.Tenant{ID: id, Name: id})
+		require.NoError(t, err)
+	}
+	acme, globex := tenant.NewContext(ctx, "acme"), tenant.NewContext(ctx, "globex")
+
+	broker := events.NewBroker()
+	t.Cleanup(broker.Close)
+	users := pg.NewUserStorage(db)
+	prs := pg.NewPullRequestStorage(db)
+	svc := app.NewService(pg.NewTeamStorage(db), users, prs, pg.NewEventStorage(db), pg.NewStatsStorage(db),
+		pg.NewTxManager(db), broker, metrics.New(nil), app.Options{})
+
+	file := &syncBuffer{}
+	journal := pg.NewEventStorage(db)
+	notifier := app.NewAssignmentNotifier(tenants, users, prs, journal, svc,
+		pg.NewLeaderLock(db, pg.NotifyLockKey), map[entity.NotificationChannel]usecase.Notifier{
+			entity.ChannelFile: notify.NewFile(file),
+		}, []entity.NotificationChannel{entity.ChannelFile})
+	go notifier.Run(ctx)
+	time.Sleep(100 * time.Millisecond)
+
+	assigned := func(pr, reviewer string) []entity.Event {
+		return []entity.Event{{Type: entity.EventReviewerAssigned, PRID: pr, AuthorID: "nx-author", ReviewerID: reviewer}}
+	}
+
+	// acme получает id раньше, а коммитит позже globex: позиция globex уже дальше, но у acme своя
+	var older []entity.Event
+	require.NoError(t, pg.NewTxManager(db).Do(acme, func(ctx context.Context) error {
+		var err error
+		if older, err = journal.Append(ctx, assigned("nx-pr-a", "nx-acme")); err != nil {
+			return err
+		}
+		newer, err := journal.Append(globex, assigned("nx-pr-g", "nx-globex"))
+		if err != nil {
+			return err
+		}
+		require.Greater(t, newer[0].ID, older[0].ID)
+		broker.Publish(newer...)
+		require.Eventually(t, func() bool { return len(file.payloads(t)) == 1 }, 5*time.Second, 50*time.Millisecond)
+		return nil
+	}))
+	broker.Publish(older...)
+
+	require.Eventually(t, func() bool { return len(file.payloads(t)) == 2 }, 5*time.Second, 50*time.Millisecond)
+	sent := file.payloads(t)
+	assert.Equal(t, []string{"nx-globex"}, sent[0].Recipients)
+	assert.Equal(t, []string{"nx-acme"}, sent[1].Recipients)
+}
+
+func TestAssignmentNotificationsOnceAcrossReplicas(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	broker := events.NewBroker()
+	t.Cleanup(broker.Close)
+	users := pg.NewUserStorage(db)
+	prs := pg.NewPullRequestStorage(db)
+	journal := pg.NewEventStorage(db)
+	svc := app.NewService(pg.NewTeamStorage(db), users, prs, journal, pg.NewStatsStorage(db),
+		pg.NewTxManager(db), broker, metrics.New(nil), app.Options{})
+
+	// Две реплики: рассылает только лидер, второй лишь ждёт лидерства
+	files := []*syncBuffer{{}, {}}
+	for _, file := range files {
+		notifier := app.NewAssignmentNotifier(pg.NewTenantStorage(db), users, prs, journal, svc,
+			pg.NewLeaderLock(db, pg.NotifyLockKey), map[entity.NotificationChannel]usecase.Notifier{
+				entity.ChannelFile: notify.NewFile(file),
+			}, []entity.NotificationChannel{entity.ChannelFile})
+		go notifier.Run(ctx)
+	}
+	time.Sleep(200 * time.Millisecond)
+	sent := func() int { return len(files[0].payloads(t)) + len(files[1].payloads(t)) }
+
+	// События записала другая реплика: в шину этого процесса они не попали
+	_, err := journal.Append(ctx, []entity.Event{
+		{Type: entity.EventReviewerAssigned, PRID: "rp-pr-1", AuthorID: "rp-author", ReviewerID: "rp-1"},
+		{Type: entity.EventReviewerAssigned, PRID: "rp-pr-1", AuthorID: "rp-author", ReviewerID: "rp-2"},
+		{Type: entity.EventPRMerged, PRID: "rp-pr-1", AuthorID: "rp-author"},
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return sent() == 2 }, 5*time.Second, 50*time.Millisecond)
+	// Несколько опросов журнала: уже отправленное не повторяется
+	time.Sleep(1500 * time.Millisecond)
+	assert.Equal(t, 2, sent())
+}
+
+func TestNotificationPrefsAPI(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	resp := client.post(t, "/team/add", map[string]any{
+		"team_name": "np-team",
+		"members":   []map[string]any{{"user_id": "np-u1", "username": "U1", "is_active": true}},
+	})
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	get := func(t *testing.T) gen.NotificationPrefs {
+		resp := client.get(t, "/users/notifications?user_id=np-u1")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var prefs gen.NotificationPrefs
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&prefs))
+		return prefs
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		prefs := get(t)
+		assert.True(t, prefs.IsDefault)
+		assert.Empty(t, prefs.Channels)
+		assert.Nil(t, prefs.QuietHours)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		resp := client.post(t, "/users/notifications", map[string]any{
+			"user_id":     "np-u1",
+			"channels":    []string{"email", "file"},
+			"email":       "u1@example.com",
+			"quiet_hours": map[string]any{"start": "22:30", "end": "07:00", "time_zone": "Europe/Moscow"},
+		})
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		prefs := get(t)
+		assert.False(t, prefs.IsDefault)
+		assert.Equal(t, []gen.NotificationChannel{gen.Email, gen.File}, prefs.Channels)
+		require.NotNil(t, prefs.Email)
+		assert.Equal(t, "u1@example.com", *prefs.Email)
+		require.NotNil(t, prefs.QuietHours)
+		assert.Equal(t, gen.QuietHours{Start: "22:30", End: "07:00", TimeZone: "Europe/Moscow"}, *prefs.QuietHours)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for name, body := range map[string]map[string]any{
+			"email without address": {"user_id": "np-u1", "channels": []string{"email"}},
+			"unknown channel":       {"user_id": "np-u1", "channels": []string{"pager"}},
+			"unknown time zone": {"user_id": "np-u1", "channels": []string{"file"},
+				"quiet_hours": map[string]any{"start": "22:00", "end": "07:00", "time_zone": "Mars/Olympus"}},
+			"bad time": {"user_id": "np-u1", "channels": []string{"file"},
+				"quiet_hours": map[string]any{"start": "25:00", "end": "07:00", "time_zone": "UTC"}},
+			"empty interval": {"user_id": "np-u1", "channels": []string{"file"},
+				"quiet_hours": map[string]any{"start": "07:00", "end": "07:00", "time_zone": "UTC"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				resp := client.post(t, "/users/notifications", body)
+				resp.Body.Close()
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			})
+		}
+	})
+
+	t.Run("Unknown user", func(t *testing.T) {
+		resp := client.get(t, "/users/notifications?user_id=no-such-user")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
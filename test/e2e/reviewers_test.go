@@ -0,0 +1,65 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+)
+
+func TestReviewerStrategyLeastLoaded(t *testing.T) {
+	db := setupTestDB(t)
+	svc := app.NewService(
+		pg.NewTeamStorage(db),
+		pg.NewUserStorage(db),
+		pg.NewPullRequestStorage(db),
+		pg.NewEventStorage(db),
+		pg.NewStatsStorage(db),
+		pg.NewTxManager(db),
+		events.NewBroker(),
+		metrics.New(nil),
+		app.Options{ReviewersPerPR: 1, Strategy: entity.StrategyLeastLoaded},
+	)
+	ctx := context.Background()
+
+	_, err := svc.AddOrUpdateTeam(ctx, entity.Team{
+		Name: "load",
+		Members: []entity.User{
+			{ID: "ld1", Username: "Author", IsActive: true},
+			{ID: "ld2", Username: "Bob", IsActive: true},
+			{ID: "ld3", Username: "Carol", IsActive: true},
+			{ID: "ld4", Username: "Dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	// Каждый следующий PR достаётся самому свободному, поэтому нагрузка выравнивается
+	load := make(map[string]int)
+	for i := range 6 {
+		pr, err := svc.CreatePR(ctx, fmt.Sprintf("ld-pr-%d", i), "PR", "ld1", entity.NoCandidateEmpty)
+		require.NoError(t, err)
+		require.Len(t, pr.Reviewers, 1)
+		load[pr.Reviewers[0]]++
+	}
+	assert.Equal(t, map[string]int{"ld2": 2, "ld3": 2, "ld4": 2}, load)
+
+	t.Run("Merged PRs do not count as load", func(t *testing.T) {
+		merged, err := svc.MergePR(ctx, "ld-pr-0", 0)
+		require.NoError(t, err)
+
+		pr, err := svc.CreatePR(ctx, "ld-pr-next", "PR", "ld1", entity.NoCandidateEmpty)
+		require.NoError(t, err)
+		assert.Equal(t, merged.Reviewers, pr.Reviewers)
+	})
+}
@@ -0,0 +1,238 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/infra/notify"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+// webhookRecorder — приёмник webhook-уведомлений
+type webhookRecorder struct {
+	mu       sync.Mutex
+	payloads map[string]notify.WebhookPayload
+}
+
+func newWebhookRecorder(t *testing.T) (*webhookRecorder, string) {
+	rec := &webhookRecorder{payloads: map[string]notify.WebhookPayload{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p notify.WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		rec.mu.Lock()
+		rec.payloads[p.PRID] = p
+		rec.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	return rec, srv.URL
+}
+
+func (r *webhookRecorder) take() map[string]notify.WebhookPayload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	got := r.payloads
+	r.payloads = map[string]notify.WebhookPayload{}
+	return got
+}
+
+func TestStaleScanner(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	now := time.Now()
+	ago := func(h int) time.Time { return now.Add(-time.Duration(h) * time.Hour) }
+
+	// sl-a: свой SLA — напомнить через сутки, переназначить через двое; sl-b — SLA по умолчанию
+	_, err := db.Exec(`
+		INSERT INTO teams (name) VALUES ('sl-a'), ('sl-b');
+		INSERT INTO team_sla (team_name, remind_after, reassign_after) VALUES ('sl-a', INTERVAL '24 hours', INTERVAL '48 hours');
+		INSERT INTO users (id, name, team_name) VALUES
+			('sla1', 'A1', 'sl-a'), ('sla2', 'A2', 'sl-a'), ('sla3', 'A3', 'sl-a'), ('sla4', 'A4', 'sl-a'),
+			('slb1', 'B1', 'sl-b'), ('slb2', 'B2', 'sl-b')`)
+	require.NoError(t, err)
+	for _, pr := range []struct {
+		id, author, status string
+		createdAt          time.Time
+	}{
+		{"sl-pr-1", "sla1", "OPEN", ago(100)},
+		{"sl-pr-2", "sla1", "OPEN", ago(10)},
+		{"sl-pr-3", "slb1", "OPEN", ago(80)},
+		{"sl-pr-4", "slb1", "MERGED", ago(80)},
+		{"sl-pr-5", "slb1", "OPEN", ago(100)},
+	} {
+		_, err := db.Exec(`INSERT INTO pull_requests (id, name, author_id, status, created_at) VALUES ($1, 'PR', $2, $3, $4)`,
+			pr.id, pr.author, pr.status, pr.createdAt)
+		require.NoError(t, err)
+	}
+	for _, ra := range []struct {
+		pr, reviewer string
+		assignedAt   time.Time
+	}{
+		{"sl-pr-1", "sla2", ago(50)},
+		{"sl-pr-1", "sla3", ago(10)},
+		{"sl-pr-3", "slb2", ago(80)},
+	} {
+		_, err := db.Exec(`INSERT INTO review_assignments (pr_id, reviewer_id, assigned_at) VALUES ($1, $2, $3)`,
+			ra.pr, ra.reviewer, ra.assignedAt)
+		require.NoError(t, err)
+	}
+
+	rec, url := newWebhookRecorder(t)
+	scanner := app.NewStaleScanner(pg.NewPullRequestStorage(db), newDeactivationService(db), notify.NewWebhook(url),
+		entity.SLA{RemindAfter: 72 * time.Hour})
+
+	report, err := scanner.Scan(ctx, now)
+	require.NoError(t, err)
+	// sla2 держит sl-pr-1 дольше 48 часов; у sl-b переназначение по умолчанию выключено
+	assert.Equal(t, entity.StaleReport{Reminded: 3, Reassigned: 1}, report)
+
+	reviewers := func(prID string) []string {
+		var ids []string
+		rows, err := db.Query(`SELECT reviewer_id FROM review_assignments WHERE pr_id = $1 ORDER BY reviewer_id`, prID)
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+		return ids
+	}
+	// Единственный кандидат — sla4: автор и оба текущих ревьювера исключены
+	assert.Equal(t, []string{"sla3", "sla4"}, reviewers("sl-pr-1"))
+	assert.Equal(t, []string{"slb2"}, reviewers("sl-pr-3"))
+
+	sent := rec.take()
+	require.Len(t, sent, 3)
+	// Напоминание уходит уже новым ревьюверам
+	assert.Equal(t, []string{"sla3", "sla4"}, sent["sl-pr-1"].Recipients)
+	assert.Equal(t, "sl-a", sent["sl-pr-1"].TeamName)
+	assert.Equal(t, entity.NotificationStalePR, sent["sl-pr-1"].Kind)
+	assert.Equal(t, []string{"slb2"}, sent["sl-pr-3"].Recipients)
+	// Ревьюверов нет — напоминаем автору
+	assert.Equal(t, []string{"slb1"}, sent["sl-pr-5"].Recipients)
+
+	var reminded int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM pull_requests WHERE reminded_at IS NOT NULL`).Scan(&reminded))
+	assert.Equal(t, 3, reminded)
+
+	t.Run("Next scan does not repeat", func(t *testing.T) {
+		report, err := scanner.Scan(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, entity.StaleReport{}, report)
+		assert.Empty(t, rec.take())
+	})
+
+	t.Run("Reminder repeats after SLA", func(t *testing.T) {
+		report, err := scanner.Scan(ctx, now.Add(25*time.Hour))
+		require.NoError(t, err)
+		// sl-pr-2 дорос до суток; sl-pr-1 — сутки с прошлого напоминания; у sl-b срок ещё не вышел
+		assert.Equal(t, 2, report.Reminded)
+		sent := rec.take()
+		assert.Contains(t, sent, "sl-pr-1")
+		assert.Contains(t, sent, "sl-pr-2")
+	})
+}
+
+func TestLeaderLock(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	first := pg.NewLeaderLock(db, pg.StaleLockKey)
+	second := pg.NewLeaderLock(db, pg.StaleLockKey)
+
+	ok, err := first.TryLead(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = second.TryLead(ctx)
+	require.NoError(t, err)
+	assert.False(t, ok, "lock is held by the first instance")
+
+	// Лидер остаётся лидером на следующих проверках
+	ok, err = first.TryLead(ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, first.Release(ctx))
+	ok, err = second.TryLead(ctx)
+	require.NoError(t, err)
+	assert.True(t, ok, "lock is free after release")
+	require.NoError(t, second.Release(ctx))
+}
+
+func TestTeamSLA(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	resp := client.post(t, "/team/add", map[string]any{
+		"team_name": "sla-team",
+		"members":   []map[string]any{{"user_id": "sl-u1", "username": "U1", "is_active": true}},
+	})
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	get := func(t *testing.T) gen.TeamSLA {
+		resp := client.get(t, "/team/sla?team_name=sla-team")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var sla gen.TeamSLA
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&sla))
+		return sla
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		sla := get(t)
+		assert.True(t, sla.IsDefault)
+		assert.Equal(t, float64(72), sla.RemindAfterHours)
+		assert.Equal(t, float64(0), sla.ReassignAfterHours)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		resp := client.post(t, "/team/sla", map[string]any{
+			"team_name": "sla-team", "remind_after_hours": 1.5, "reassign_after_hours": 48,
+		})
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		sla := get(t)
+		assert.False(t, sla.IsDefault)
+		assert.Equal(t, 1.5, sla.RemindAfterHours)
+		assert.Equal(t, float64(48), sla.ReassignAfterHours)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		resp := client.post(t, "/team/sla", map[string]any{"team_name": "sla-team", "remind_after_hours": 0})
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Unknown team", func(t *testing.T) {
+		resp := client.get(t, "/team/sla?team_name=no-such-team")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp = client.post(t, "/team/sla", map[string]any{"team_name": "no-such-team", "remind_after_hours": 24})
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,361 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+func TestPRStatsMergeTime(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	// Время создания и merge задаём напрямую: через API все PR получили бы одинаковое «сейчас»
+	_, err := db.Exec(`
+		INSERT INTO teams (name) VALUES ('st-a'), ('st-b');
+		INSERT INTO users (id, name, team_name) VALUES ('sa1', 'A1', 'st-a'), ('sa2', 'A2', 'st-a'), ('sb1', 'B1', 'st-b');
+		INSERT INTO pull_requests (id, name, author_id, status, created_at, merged_at) VALUES
+			('st-pr-1', 'PR', 'sa1', 'MERGED', '2026-01-01 00:00', '2026-01-01 01:00'),
+			('st-pr-2', 'PR', 'sa1', 'MERGED', '2026-01-02 00:00', '2026-01-02 03:00'),
+			('st-pr-3', 'PR', 'sa2', 'MERGED', '2026-01-03 00:00', '2026-01-03 11:00'),
+			('st-pr-4', 'PR', 'sa2', 'OPEN', '2026-01-04 00:00', NULL),
+			('st-pr-5', 'PR', 'sb1', 'MERGED', '2026-02-01 00:00', '2026-02-05 04:00');
+		INSERT INTO pr_events (type, pr_id, created_at) VALUES
+			('PR_CREATED', 'st-pr-1', '2026-01-01 00:00'),
+			('REVIEWER_ASSIGNED', 'st-pr-1', '2026-01-01 00:30'),
+			('REVIEWER_ASSIGNED', 'st-pr-1', '2026-01-01 02:00'),
+			('PR_CREATED', 'st-pr-3', '2026-01-03 00:00');
+	`)
+	require.NoError(t, err)
+
+	stats := func(t *testing.T, query string) gen.PRStats {
+		resp := client.get(t, "/pullRequest/stats"+query)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var stats gen.PRStats
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&stats))
+		require.NotNil(t, stats.MergeTime)
+		require.NotNil(t, stats.FirstReviewTime)
+		return stats
+	}
+
+	t.Run("All PRs", func(t *testing.T) {
+		s := stats(t, "")
+		assert.Equal(t, 5, s.Total)
+		assert.Equal(t, 4, s.Merged)
+		// 1, 3, 11 и 100 часов
+		assert.Equal(t, 4, s.MergeTime.Count)
+		assert.InDelta(t, 28.75, *s.MergeTime.AvgHours, 1e-9)
+		assert.InDelta(t, 7, *s.MergeTime.MedianHours, 1e-9)
+	})
+
+	t.Run("Team filter", func(t *testing.T) {
+		s := stats(t, "?team_name=st-a")
+		assert.Equal(t, 4, s.Total)
+		assert.Equal(t, 1, s.Open)
+		// percentile_cont интерполирует: p90 из 1, 3, 11 — 3 + 0.8·8
+		assert.Equal(t, 3, s.MergeTime.Count)
+		assert.InDelta(t, 5, *s.MergeTime.AvgHours, 1e-9)
+		assert.InDelta(t, 3, *s.MergeTime.MedianHours, 1e-9)
+		assert.InDelta(t, 9.4, *s.MergeTime.P90Hours, 1e-9)
+		assert.InDelta(t, 10.84, *s.MergeTime.P99Hours, 1e-9)
+
+		// Первое назначение — через полчаса, повторное не в счёт; st-pr-3 ещё ждёт ревьювера
+		assert.Equal(t, 1, s.FirstReviewTime.Count)
+		assert.InDelta(t, 0.5, *s.FirstReviewTime.MedianHours, 1e-9)
+	})
+
+	t.Run("Date range", func(t *testing.T) {
+		s := stats(t, "?team_name=st-a&from=2026-01-02T00:00:00Z&to=2026-01-04T00:00:00Z")
+		assert.Equal(t, 2, s.Total)
+		assert.Equal(t, 2, s.MergeTime.Count)
+		assert.InDelta(t, 7, *s.MergeTime.MedianHours, 1e-9)
+		assert.Equal(t, 0, s.FirstReviewTime.Count)
+		assert.Nil(t, s.FirstReviewTime.MedianHours)
+	})
+
+	t.Run("Unknown team and empty range", func(t *testing.T) {
+		resp := client.get(t, "/pullRequest/stats?team_name=nope")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp = client.get(t, "/pullRequest/stats?from=2026-01-02T00:00:00Z&to=2026-01-02T00:00:00Z")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestTeamStats(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	// Назначения на смерженный PR триггер запрещает, поэтому tc-pr-4 смерживаем после назначения
+	_, err := db.Exec(`
+		INSERT INTO teams (name) VALUES ('st-c');
+		INSERT INTO users (id, name, is_active, team_name) VALUES
+			('tc1', 'C1', true, 'st-c'), ('tc2', 'C2', true, 'st-c'),
+			('tc3', 'C3', true, 'st-c'), ('tc4', 'C4', false, 'st-c');
+		INSERT INTO pull_requests (id, name, author_id, status, created_at) VALUES
+			('tc-pr-1', 'Full', 'tc1', 'OPEN', '2026-01-01 00:00'),
+			('tc-pr-2', 'One reviewer', 'tc1', 'OPEN', '2026-01-02 00:00'),
+			('tc-pr-3', 'No reviewers', 'tc2', 'OPEN', '2026-01-03 00:00'),
+			('tc-pr-4', 'Merged', 'tc3', 'OPEN', '2026-01-04 00:00');
+		INSERT INTO review_assignments (pr_id, reviewer_id) VALUES
+			('tc-pr-1', 'tc2'), ('tc-pr-1', 'tc3'), ('tc-pr-2', 'tc2'), ('tc-pr-4', 'tc2');
+		UPDATE pull_requests SET status = 'MERGED', merged_at = '2026-01-04 02:00' WHERE id = 'tc-pr-4';
+	`)
+	require.NoError(t, err)
+
+	resp := client.get(t, "/team/stats?team_name=st-c")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var stats gen.TeamStats
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&stats))
+
+	assert.Equal(t, 3, stats.PullRequests.Open)
+	assert.Equal(t, 1, stats.PullRequests.Merged)
+	require.NotNil(t, stats.PullRequests.MergeTime)
+	assert.InDelta(t, 2, *stats.PullRequests.MergeTime.MedianHours, 1e-9)
+
+	// Смерженный PR в нагрузку не входит
+	assert.Equal(t, []gen.MemberLoad{
+		{UserId: "tc2", Username: "C2", IsActive: true, OpenReviews: 2},
+		{UserId: "tc3", Username: "C3", IsActive: true, OpenReviews: 1},
+		{UserId: "tc1", Username: "C1", IsActive: true, OpenReviews: 0},
+		{UserId: "tc4", Username: "C4", IsActive: false, OpenReviews: 0},
+	}, stats.Members)
+	// Активные 0, 1, 2: G = 2·(1·0 + 2·1 + 3·2) / (3·3) − 4/3 = 4/9
+	assert.InDelta(t, 4.0/9, stats.LoadGini, 1e-9)
+
+	assert.Equal(t, 2, stats.RequiredReviewers)
+	require.Len(t, stats.Understaffed, 2)
+	assert.Equal(t, "tc-pr-2", stats.Understaffed[0].PullRequestId)
+	assert.Equal(t, []string{"tc2"}, stats.Understaffed[0].AssignedReviewers)
+	assert.Equal(t, "tc-pr-3", stats.Understaffed[1].PullRequestId)
+	assert.Empty(t, stats.Understaffed[1].AssignedReviewers)
+
+	t.Run("Unknown team", func(t *testing.T) {
+		resp := client.get(t, "/team/stats?team_name=nope")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestStatsTimeseries(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	// 2026-01-05 — понедельник; время до merge: 2, 24 и 4 часа
+	_, err := db.Exec(`
+		INSERT INTO teams (name) VALUES ('ts-a'), ('ts-b');
+		INSERT INTO users (id, name, team_name) VALUES ('ta1', 'A1', 'ts-a'), ('ta2', 'A2', 'ts-a'), ('tb1', 'B1', 'ts-b');
+		INSERT INTO pull_requests (id, name, author_id, status, created_at, merged_at) VALUES
+			('ts-pr-1', 'PR', 'ta1', 'MERGED', '2026-01-05 10:00', '2026-01-05 12:00'),
+			('ts-pr-2', 'PR', 'ta1', 'MERGED', '2026-01-06 00:00', '2026-01-07 00:00'),
+			('ts-pr-3', 'PR', 'ta2', 'OPEN', '2026-01-07 09:00', NULL),
+			('ts-pr-4', 'PR', 'tb1', 'MERGED', '2026-01-13 00:00', '2026-01-13 04:00');
+		INSERT INTO pr_events (type, pr_id, author_id, team_name, reviewer_id, created_at) VALUES
+			('REVIEWER_ASSIGNED', 'ts-pr-1', 'ta1', 'ts-a', 'ta2', '2026-01-05 10:00'),
+			('REVIEWER_ASSIGNED', 'ts-pr-3', 'ta2', 'ts-a', 'ta1', '2026-01-07 09:00'),
+			('REVIEWER_UNASSIGNED', 'ts-pr-3', 'ta2', 'ts-a', 'ta1', '2026-01-08 15:00');
+	`)
+	require.NoError(t, err)
+
+	svc := newDeactivationService(db)
+	rows, err := svc.RollupStats(context.Background(), time.Time{}, time.Date(2026, 1, 20, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Positive(t, rows)
+
+	type point struct {
+		start                                string
+		opened, merged, assigned, reassigned int
+		median                               *float64
+	}
+	hours := func(h float64) *float64 { return &h }
+	series := func(t *testing.T, query string) []point {
+		resp := client.get(t, "/stats/timeseries"+query)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var points []gen.TimeseriesPoint
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&points))
+		res := make([]point, len(points))
+		for i, p := range points {
+			res[i] = point{p.Start.Format(time.DateOnly), p.PrsOpened, p.PrsMerged, p.ReviewsAssigned, p.Reassignments, p.MedianMergeHours}
+		}
+		return res
+	}
+
+	t.Run("Team by day", func(t *testing.T) {
+		assert.Equal(t, []point{
+			{"2026-01-05", 1, 1, 1, 0, hours(2)},
+			{"2026-01-06", 1, 0, 0, 0, nil},
+			{"2026-01-07", 1, 1, 1, 0, hours(24)},
+			{"2026-01-08", 0, 0, 0, 1, nil},
+		}, series(t, "?team_name=ts-a&from=2026-01-05&to=2026-01-09"))
+	})
+
+	t.Run("All teams by week", func(t *testing.T) {
+		// Медиана недели — по всем PR недели, а не по дневным медианам
+		assert.Equal(t, []point{
+			{"2026-01-05", 3, 2, 2, 1, hours(13)},
+			{"2026-01-12", 1, 1, 0, 0, hours(4)},
+		}, series(t, "?granularity=week&from=2026-01-05&to=2026-01-19"))
+	})
+
+	t.Run("User by week", func(t *testing.T) {
+		// PR — по автору, назначения и снятия — по ревьюверу
+		assert.Equal(t, []point{
+			{"2026-01-05", 2, 2, 1, 1, hours(13)},
+			{"2026-01-12", 0, 0, 0, 0, nil},
+		}, series(t, "?granularity=week&user_id=ta1&from=2026-01-05&to=2026-01-19"))
+	})
+
+	t.Run("Month", func(t *testing.T) {
+		assert.Equal(t, []point{
+			{"2026-01-01", 4, 3, 2, 1, hours(4)},
+			{"2026-02-01", 0, 0, 0, 0, nil},
+		}, series(t, "?granularity=month&from=2026-01-01&to=2026-03-01"))
+	})
+
+	t.Run("Rollup is repeatable", func(t *testing.T) {
+		_, err := svc.RollupStats(context.Background(), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, []point{
+			{"2026-01-05", 3, 2, 2, 1, hours(13)},
+			{"2026-01-12", 1, 1, 0, 0, hours(4)},
+		}, series(t, "?granularity=week&from=2026-01-05&to=2026-01-19"))
+	})
+
+	t.Run("Invalid filters", func(t *testing.T) {
+		for query, status := range map[string]int{
+			"?team_name=nope":                http.StatusNotFound,
+			"?user_id=nope":                  http.StatusNotFound,
+			"?team_name=ts-a&user_id=ta1":    http.StatusBadRequest,
+			"?from=2026-01-05&to=2026-01-05": http.StatusBadRequest,
+			"?granularity=year":              http.StatusBadRequest,
+		} {
+			resp := client.get(t, "/stats/timeseries"+query)
+			resp.Body.Close()
+			assert.Equal(t, status, resp.StatusCode, query)
+		}
+	})
+}
+
+func TestUsersLeaderboard(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	// lb-pr-1 и lb-pr-2 смержены (ревью la2 — 8 и 4 часа, la3 без события в журнале — 10 часов от создания),
+	// lb-pr-3 и lb-pr-4 открыты; la1 сняли с lb-pr-4 и назначили la2
+	_, err := db.Exec(`
+		INSERT INTO teams (name) VALUES ('lb-a'), ('lb-b');
+		INSERT INTO users (id, name, is_active, team_name) VALUES
+			('la1', 'A1', true, 'lb-a'), ('la2', 'A2', true, 'lb-a'), ('la3', 'A3', false, 'lb-a'), ('lb1', 'B1', true, 'lb-b');
+		INSERT INTO pull_requests (id, name, author_id, status, created_at) VALUES
+			('lb-pr-1', 'PR', 'la1', 'OPEN', '2026-03-02 00:00'),
+			('lb-pr-2', 'PR', 'la1', 'OPEN', '2026-03-03 00:00'),
+			('lb-pr-3', 'PR', 'la2', 'OPEN', '2026-03-04 00:00'),
+			('lb-pr-4', 'PR', 'la3', 'OPEN', '2026-03-05 00:00');
+		INSERT INTO review_assignments (pr_id, reviewer_id) VALUES
+			('lb-pr-1', 'la2'), ('lb-pr-1', 'la3'), ('lb-pr-2', 'la2'),
+			('lb-pr-3', 'la1'), ('lb-pr-3', 'la3'), ('lb-pr-4', 'la2');
+		UPDATE pull_requests SET status = 'MERGED', merged_at = '2026-03-02 10:00' WHERE id = 'lb-pr-1';
+		UPDATE pull_requests SET status = 'MERGED', merged_at = '2026-03-03 04:00' WHERE id = 'lb-pr-2';
+		INSERT INTO pr_events (type, pr_id, team_name, reviewer_id, created_at) VALUES
+			('REVIEWER_ASSIGNED', 'lb-pr-1', 'lb-a', 'la2', '2026-03-02 02:00'),
+			('REVIEWER_ASSIGNED', 'lb-pr-2', 'lb-a', 'la2', '2026-03-03 00:00'),
+			('REVIEWER_ASSIGNED', 'lb-pr-3', 'lb-a', 'la1', '2026-03-04 00:00'),
+			('REVIEWER_ASSIGNED', 'lb-pr-3', 'lb-a', 'la3', '2026-03-04 00:00'),
+			('REVIEWER_ASSIGNED', 'lb-pr-4', 'lb-a', 'la1', '2026-03-05 00:00'),
+			('REVIEWER_UNASSIGNED', 'lb-pr-4', 'lb-a', 'la1', '2026-03-06 00:00'),
+			('REVIEWER_ASSIGNED', 'lb-pr-4', 'lb-a', 'la2', '2026-03-06 00:00');
+	`)
+	require.NoError(t, err)
+
+	const march = "from=2026-03-01T00:00:00Z&to=2026-04-01T00:00:00Z"
+	leaderboard := func(t *testing.T, query string) []gen.LeaderboardEntry {
+		resp := client.get(t, "/users/leaderboard?"+query)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var entries []gen.LeaderboardEntry
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&entries))
+		return entries
+	}
+	order := func(entries []gen.LeaderboardEntry) []string {
+		ids := make([]string, len(entries))
+		for i, e := range entries {
+			require.Equal(t, i+1, e.Rank)
+			ids[i] = e.UserId
+		}
+		return ids
+	}
+	hours := func(h float64) *float64 { return &h }
+
+	t.Run("Team by completed reviews", func(t *testing.T) {
+		entries := leaderboard(t, "team_name=lb-a&"+march)
+		assert.Equal(t, []gen.LeaderboardEntry{
+			{Rank: 1, UserId: "la2", Username: "A2", TeamName: "lb-a", IsActive: true, ReviewsCompleted: 2, OpenReviews: 1,
+				AvgTurnaroundHours: hours(6), AssignmentsReceived: 3, ReassignAwayRate: hours(0)},
+			{Rank: 2, UserId: "la3", Username: "A3", TeamName: "lb-a", IsActive: false, ReviewsCompleted: 1, OpenReviews: 1,
+				AvgTurnaroundHours: hours(10), AssignmentsReceived: 1, ReassignAwayRate: hours(0)},
+			{Rank: 3, UserId: "la1", Username: "A1", TeamName: "lb-a", IsActive: true, ReviewsCompleted: 0, OpenReviews: 1,
+				AssignmentsReceived: 2, ReassignedAway: 1, ReassignAwayRate: hours(1)},
+		}, entries)
+	})
+
+	t.Run("Sort orders", func(t *testing.T) {
+		// Равные значения — по user_id, отсутствующие — в конце
+		assert.Equal(t, []string{"la1", "la2", "la3"}, order(leaderboard(t, "team_name=lb-a&sort_by=open&"+march)))
+		assert.Equal(t, []string{"la2", "la3", "la1"}, order(leaderboard(t, "team_name=lb-a&sort_by=turnaround&"+march)))
+		assert.Equal(t, []string{"la2", "la3", "la1"}, order(leaderboard(t, "team_name=lb-a&sort_by=reassign_rate&"+march)))
+	})
+
+	t.Run("Whole organization and narrower window", func(t *testing.T) {
+		assert.Equal(t, []string{"la2", "la3", "la1", "lb1"}, order(leaderboard(t, march)))
+
+		// lb-pr-1 смержен до начала периода
+		entries := leaderboard(t, "team_name=lb-a&from=2026-03-03T00:00:00Z&to=2026-04-01T00:00:00Z")
+		require.Equal(t, "la2", entries[0].UserId)
+		assert.Equal(t, 1, entries[0].ReviewsCompleted)
+		assert.Equal(t, hours(4), entries[0].AvgTurnaroundHours)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		resp := client.get(t, "/users/leaderboard?team_name=lb-a&format=csv&"+march)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Equal(t, "reassign_away_rate", records[0][10])
+		assert.Equal(t, []string{"1", "la2", "A2", "lb-a", "true", "2", "1", "6", "3", "0", "0"}, records[1])
+		assert.Equal(t, []string{"3", "la1", "A1", "lb-a", "true", "0", "1", "", "2", "1", "1"}, records[3])
+	})
+
+	t.Run("Invalid filters", func(t *testing.T) {
+		resp := client.get(t, "/users/leaderboard?team_name=nope")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp = client.get(t, "/users/leaderboard?sort_by=name")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
 )
 
 var (
@@ -136,28 +138,37 @@ func applyMigrations(databaseURL string) error {
 	return nil
 }
 
-// Используй эту функцию в каждом e2e-тесте — она возвращает чистую БД
-func setupTestDB(t *testing.T) *sql.DB {
-	db, err := sql.Open("postgres", dbURL)
+// Используй эту функцию в каждом e2e-тесте — она возвращает чистую БД.
+// Очистка идёт от владельца таблиц, а тесту отдаётся пул как у сервиса: под ролью арендатора,
+// запросы без арендатора в ctx — в арендаторе default.
+func setupTestDB(t testing.TB) *sql.DB {
+	admin, err := sql.Open("postgres", dbURL)
 	require.NoError(t, err, "Не удалось подключиться к тестовой БД")
+	defer admin.Close()
 
 	// Полная очистка всех таблиц в схеме public
-	_, err = db.Exec(`
+	_, err = admin.Exec(`
 		DO $$ DECLARE
 		    r RECORD;
 		BEGIN
 		    -- Отключаем проверку внешних ключей на время очистки
 		    SET session_replication_role = replica;
 
-		    FOR r IN (SELECT tablename FROM pg_tables WHERE schemaname = 'public') LOOP
+		    -- schema_migrations не трогаем: по ней /health/ready сверяет версию схемы.
+		    -- tenants — тоже: арендатор default создаёт миграция
+		    FOR r IN (SELECT tablename FROM pg_tables WHERE schemaname = 'public' AND tablename NOT IN ('schema_migrations', 'tenants')) LOOP
 		        EXECUTE 'TRUNCATE TABLE ' || quote_ident(r.tablename) || ' RESTART IDENTITY CASCADE';
 		    END LOOP;
+		    DELETE FROM tenants WHERE id <> 'default';
 
 		    SET session_replication_role = origin;
 		END $$;
 	`)
 	require.NoError(t, err, "Не удалось очистить таблицы")
 
+	db, err := pg.Open(dbURL, pg.DefaultTenantRole)
+	require.NoError(t, err, "Не удалось подключиться к тестовой БД")
+
 	t.Cleanup(func() { db.Close() })
 	return db
 }
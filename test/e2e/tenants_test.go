@@ -0,0 +1,234 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/tenant"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/auth"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	tenants := pg.NewTenantStorage(db)
+	keys := pg.NewAPIKeyStorage(db)
+	apiKey := map[string]map[string]string{}
+	for _, id := range []string{"acme", "globex"} {
+		_, err := tenants.Create(ctx, entity.Tenant{ID: id, Name: id})
+		require.NoError(t, err)
+		_, err = auth.SaveAPIKey(tenant.NewContext(ctx, id), keys, id+"-admin", id+"-key", entity.RoleAdmin, "")
+		require.NoError(t, err)
+		apiKey[id] = map[string]string{"X-API-Key": id + "-key"}
+	}
+	_, err := tenants.Create(ctx, entity.Tenant{ID: "acme"})
+	require.ErrorIs(t, err, usecase.ErrTenantExists)
+
+	// Одинаковые идентификаторы у разных арендаторов не конфликтуют
+	for _, id := range []string{"acme", "globex"} {
+		resp := client.doAs(t, http.MethodPost, "/team/add", gen.Team{
+			TeamName: "tn-core",
+			Members: []gen.TeamMember{
+				{UserId: "tn-u1", Username: "Author", IsActive: true},
+				{UserId: "tn-u2", Username: "Bob", IsActive: true},
+				{UserId: "tn-u3", Username: "Carol", IsActive: true},
+				{UserId: "tn-u4", Username: "Dave", IsActive: true},
+			},
+		}, apiKey[id])
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	svc := newDeactivationService(db)
+	acme, globex := tenant.NewContext(ctx, "acme"), tenant.NewContext(ctx, "globex")
+	for _, c := range []context.Context{acme, globex} {
+		_, err := svc.CreatePR(c, "tn-pr-1", "PR", "tn-u1", entity.NoCandidateFail)
+		require.NoError(t, err)
+	}
+	_, err = svc.CreatePR(globex, "tn-pr-2", "PR", "tn-u1", entity.NoCandidateFail)
+	require.NoError(t, err)
+
+	t.Run("Credentials pick the tenant", func(t *testing.T) {
+		resp := client.get(t, "/team/get?team_name=tn-core")
+		requireErrorCode(t, resp, http.StatusNotFound, gen.NOTFOUND)
+
+		resp = client.doAs(t, http.MethodGet, "/team/get?team_name=tn-core", nil,
+			map[string]string{"X-API-Key": "acme-key", "X-Tenant-ID": "acme"})
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = client.doAs(t, http.MethodGet, "/team/get?team_name=tn-core", nil,
+			map[string]string{"X-API-Key": "acme-key", "X-Tenant-ID": "globex"})
+		requireErrorCode(t, resp, http.StatusForbidden, gen.FORBIDDEN)
+	})
+
+	t.Run("Header picks the tenant when auth is disabled", func(t *testing.T) {
+		open := newTestClientWith(db, auth.Disabled(tenants))
+		t.Cleanup(open.Close)
+
+		resp := open.doAs(t, http.MethodGet, "/team/get?team_name=tn-core", nil, map[string]string{"X-Tenant-ID": "globex"})
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = open.doAs(t, http.MethodGet, "/team/get?team_name=tn-core", nil, map[string]string{"X-Tenant-ID": "no-such-tenant"})
+		requireErrorCode(t, resp, http.StatusNotFound, gen.NOTFOUND)
+	})
+
+	t.Run("Deactivation stays within tenant", func(t *testing.T) {
+		before, err := svc.GetPR(globex, "tn-pr-1")
+		require.NoError(t, err)
+		reviewer := before.Reviewers[0]
+
+		require.NoError(t, svc.DeactivateUsersAndReassign(acme, "tn-core", []string{reviewer}, 0))
+
+		pr, err := svc.GetPR(acme, "tn-pr-1")
+		require.NoError(t, err)
+		assert.NotContains(t, pr.Reviewers, reviewer)
+
+		after, err := svc.GetPR(globex, "tn-pr-1")
+		require.NoError(t, err)
+		assert.Equal(t, before.Reviewers, after.Reviewers)
+		user, err := svc.GetUser(globex, reviewer)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+	})
+
+	t.Run("Stats stay within tenant", func(t *testing.T) {
+		stats, err := svc.GetPRStats(acme, entity.StatsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Total)
+		stats, err = svc.GetPRStats(globex, entity.StatsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.Total)
+		stats, err = svc.GetPRStats(ctx, entity.StatsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, stats.Total)
+
+		user, err := svc.GetUserStats(globex, "tn-u1")
+		require.NoError(t, err)
+		assert.Equal(t, 2, user.CreatedPRCount)
+	})
+
+	t.Run("Grouped queries use tenant keys", func(t *testing.T) {
+		// После 011 первичные ключи составные: GROUP BY по одному id не определяет остальные колонки
+		prs, err := pg.NewTeamStorage(db).Understaffed(globex, "tn-core", false, 3)
+		require.NoError(t, err)
+		assert.Len(t, prs, 2)
+
+		_, err = svc.SetTeamSLA(globex, "tn-core", entity.SLA{RemindAfter: time.Hour})
+		require.NoError(t, err)
+		stale, err := pg.NewPullRequestStorage(db).ListStale(globex, time.Now().Add(2*time.Hour), entity.SLA{RemindAfter: 24 * time.Hour})
+		require.NoError(t, err)
+		require.Len(t, stale, 2)
+		assert.Equal(t, "tn-core", stale[0].TeamName)
+		assert.Equal(t, time.Hour, stale[0].SLA.RemindAfter)
+	})
+
+	t.Run("Row-level security", func(t *testing.T) {
+		count := func(ctx context.Context) int {
+			var n int
+			require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM pull_requests`).Scan(&n))
+			return n
+		}
+		assert.Equal(t, 1, count(acme))
+		assert.Equal(t, 2, count(globex))
+		assert.Equal(t, 0, count(ctx))
+
+		// Чужой tenant_id не пропускает WITH CHECK политики
+		_, err := db.ExecContext(acme, `INSERT INTO teams (tenant_id, name) VALUES ('globex', 'tn-intruder')`)
+		require.Error(t, err)
+		res, err := db.ExecContext(acme, `UPDATE users SET is_active = false WHERE tenant_id = 'globex'`)
+		require.NoError(t, err)
+		n, err := res.RowsAffected()
+		require.NoError(t, err)
+		assert.Zero(t, n)
+
+		// Ключи чужого арендатора не читаются, но по хешу находятся
+		var keys int
+		require.NoError(t, db.QueryRowContext(acme, `SELECT COUNT(*) FROM api_keys`).Scan(&keys))
+		assert.Equal(t, 1, keys)
+		key, err := pg.NewAPIKeyStorage(db).GetByHash(ctx, auth.HashAPIKey("globex-key"))
+		require.NoError(t, err)
+		assert.Equal(t, "globex", key.TenantID)
+
+		_, err = auth.SaveAPIKey(globex, pg.NewAPIKeyStorage(db), "stolen", "acme-key", entity.RoleAdmin, "")
+		require.ErrorIs(t, err, usecase.ErrAPIKeyTaken)
+	})
+
+	t.Run("Event stream is per tenant", func(t *testing.T) {
+		acmeClient := *client
+		acmeClient.client = &http.Client{Transport: apiKeyTransport{key: "acme-key"}}
+		stream := acmeClient.openStream(t, "", "")
+
+		// Шина у сервиса testClient своя: PR создаём через API
+		for id, pr := range map[string]string{"globex": "tn-pr-g", "acme": "tn-pr-a"} {
+			resp := client.doAs(t, http.MethodPost, "/pullRequest/create", map[string]any{
+				"pull_request_id": pr, "pull_request_name": "PR", "author_id": "tn-u1",
+			}, apiKey[id])
+			resp.Body.Close()
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+		// В потоке acme только его PR
+		got := readEvents(t, stream, 1)
+		assert.Equal(t, "tn-pr-a", got[0].Data.PullRequestID)
+
+		resp := client.doAs(t, http.MethodGet, "/pullRequest/stats", nil, apiKey["acme"])
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var stats gen.PRStats
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&stats))
+		assert.Equal(t, 2, stats.Total)
+	})
+
+	t.Run("Event writes lock per tenant", func(t *testing.T) {
+		events := pg.NewEventStorage(db)
+		event := []entity.Event{{Type: entity.EventPRCreated, PRID: "tn-lock"}}
+		locked, release := make(chan struct{}), make(chan struct{})
+		done := make(chan error, 1)
+		go func() {
+			done <- pg.NewTxManager(db).Do(acme, func(ctx context.Context) error {
+				if _, err := events.Append(ctx, event); err != nil {
+					return err
+				}
+				close(locked)
+				<-release
+				return nil
+			})
+		}()
+		select {
+		case <-locked:
+		case err := <-done:
+			require.NoError(t, err)
+		}
+
+		// Пока транзакция acme держит лок, globex пишет без ожидания, а acme ждёт
+		wait, cancel := context.WithTimeout(globex, time.Second)
+		defer cancel()
+		_, err := events.Append(wait, event)
+		require.NoError(t, err)
+
+		wait, cancel = context.WithTimeout(acme, 200*time.Millisecond)
+		defer cancel()
+		_, err = events.Append(wait, event)
+		require.Error(t, err)
+
+		close(release)
+		require.NoError(t, <-done)
+	})
+}
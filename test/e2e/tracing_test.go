@@ -0,0 +1,96 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	// Другие тесты пакета тоже пишут спаны в глобальный провайдер, поэтому смотрим только свою трассировку
+	spansOf := func(traceID string) map[string]sdktrace.ReadOnlySpan {
+		byName := map[string]sdktrace.ReadOnlySpan{}
+		for _, s := range recorder.Ended() {
+			if s.SpanContext().TraceID().String() == traceID {
+				byName[s.Name()] = s
+			}
+		}
+		return byName
+	}
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+	traceparent := "00-" + traceID + "-" + parentSpanID + "-01"
+
+	resp := client.doAs(t, http.MethodPost, "/team/add", gen.Team{
+		TeamName: "tracing",
+		Members:  []gen.TeamMember{{UserId: "tr-a", Username: "A", IsActive: true}},
+	}, map[string]string{"X-API-Key": adminAPIKey, "traceparent": traceparent})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	t.Run("Incoming traceparent continues the trace through all layers", func(t *testing.T) {
+		spans := spansOf(traceID)
+
+		server, ok := spans["POST /team/add"]
+		require.True(t, ok, "server span is missing")
+		assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+		assert.Equal(t, parentSpanID, server.Parent().SpanID().String())
+		assert.True(t, server.Parent().IsRemote())
+
+		svc, ok := spans["Service.AddOrUpdateTeam"]
+		require.True(t, ok, "service span is missing")
+		assert.Equal(t, server.SpanContext().SpanID(), svc.Parent().SpanID())
+
+		assert.Contains(t, spans, "TxManager.Do")
+		assert.Contains(t, spans, "TeamRepository.Save")
+		assert.Contains(t, spans, "UserRepository.SaveUpdateMany")
+	})
+
+	t.Run("Domain error is recorded on the service span", func(t *testing.T) {
+		const otherTraceID = "5bf92f3577b34da6a3ce929d0e0e4737"
+
+		resp := client.doAs(t, http.MethodGet, "/team/get?team_name=missing", nil,
+			map[string]string{"X-API-Key": adminAPIKey, "traceparent": "00-" + otherTraceID + "-" + parentSpanID + "-01"})
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		resp.Body.Close()
+
+		spans := spansOf(otherTraceID)
+		svc, ok := spans["Service.GetTeam"]
+		require.True(t, ok, "service span is missing")
+		assert.Equal(t, codes.Error, svc.Status().Code)
+
+		server, ok := spans["GET /team/get"]
+		require.True(t, ok, "server span is missing")
+		assert.Equal(t, codes.Unset, server.Status().Code, "4xx is not a server error")
+	})
+}
@@ -0,0 +1,296 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mark47B/be-internship/internal/app"
+	"github.com/mark47B/be-internship/internal/domain/entity"
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/events"
+	"github.com/mark47B/be-internship/internal/infra/metrics"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transfer"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+)
+
+func TestExportImport(t *testing.T) {
+	db := setupTestDB(t)
+	svc := app.NewService(
+		pg.NewTeamStorage(db),
+		pg.NewUserStorage(db),
+		pg.NewPullRequestStorage(db),
+		pg.NewEventStorage(db),
+		pg.NewStatsStorage(db),
+		pg.NewTxManager(db),
+		events.NewBroker(),
+		metrics.New(nil),
+		app.Options{},
+	)
+	ctx := context.Background()
+
+	_, err := svc.AddOrUpdateTeam(ctx, entity.Team{
+		Name: "transfer",
+		Members: []entity.User{
+			{ID: "tr1", Username: "Alice", IsActive: true},
+			{ID: "tr2", Username: "Bob", IsActive: true},
+			{ID: "tr3", Username: "Carol", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = svc.CreatePR(ctx, "tr-pr-1", "Open", "tr1", entity.NoCandidateEmpty)
+	require.NoError(t, err)
+	_, err = svc.CreatePR(ctx, "tr-pr-2", "Merged", "tr2", entity.NoCandidateEmpty)
+	require.NoError(t, err)
+	_, err = svc.MergePR(ctx, "tr-pr-2", 0)
+	require.NoError(t, err)
+
+	export := func(format transfer.Format) string {
+		var buf bytes.Buffer
+		enc := transfer.NewWriter(&buf, format)
+		require.NoError(t, svc.Export(ctx, enc.Write))
+		require.NoError(t, enc.Flush())
+		return buf.String()
+	}
+	dump := export(transfer.FormatJSONL)
+
+	t.Run("Re-import of the same dump changes nothing", func(t *testing.T) {
+		result, err := svc.Import(ctx, transfer.NewJSONLReader(strings.NewReader(dump)), false)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ImportCounts{Unchanged: 1}, result.Teams)
+		assert.Equal(t, entity.ImportCounts{Unchanged: 3}, result.Users)
+		assert.Equal(t, entity.ImportCounts{Unchanged: 2}, result.PullRequests)
+		assert.Equal(t, dump, export(transfer.FormatJSONL))
+	})
+
+	t.Run("CSV round-trip gives the same records", func(t *testing.T) {
+		fromCSV, err := transfer.ReadCSV(strings.NewReader(export(transfer.FormatCSV)))
+		require.NoError(t, err)
+		fromJSONL, err := transfer.ReadJSONL(strings.NewReader(dump))
+		require.NoError(t, err)
+
+		require.Len(t, fromCSV, len(fromJSONL))
+		for i := range fromCSV {
+			fromCSV[i].Line, fromJSONL[i].Line = 0, 0
+			if pr := fromCSV[i].PullRequest; pr != nil {
+				assert.True(t, pr.CreatedAt.Equal(*fromJSONL[i].PullRequest.CreatedAt))
+				pr.CreatedAt, fromJSONL[i].PullRequest.CreatedAt = nil, nil
+				pr.MergedAt, fromJSONL[i].PullRequest.MergedAt = nil, nil
+			}
+		}
+		assert.Equal(t, fromJSONL, fromCSV)
+	})
+
+	t.Run("Import into an empty database restores the dump", func(t *testing.T) {
+		// Очистка той же БД: svc продолжает работать с пустыми таблицами
+		setupTestDB(t)
+
+		result, err := svc.Import(ctx, transfer.NewJSONLReader(strings.NewReader(dump)), false)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ImportCounts{Created: 1}, result.Teams)
+		assert.Equal(t, entity.ImportCounts{Created: 3}, result.Users)
+		assert.Equal(t, entity.ImportCounts{Created: 2}, result.PullRequests)
+
+		pr, err := svc.GetPR(ctx, "tr-pr-2")
+		require.NoError(t, err)
+		assert.Equal(t, entity.PRMerged, pr.Status)
+		assert.Equal(t, dump, export(transfer.FormatJSONL))
+	})
+
+	t.Run("Conflicts are reported and nothing is written", func(t *testing.T) {
+		src := transfer.NewJSONLReader(strings.NewReader(strings.Join([]string{
+			`{"kind":"team","team_name":"transfer-new","members":[{"user_id":"tr9","username":"Zed","is_active":true},{"user_id":"tr1","username":"Alice","is_active":true}]}`,
+			``,
+			`{"kind":"pull_request","pull_request_id":"tr-pr-3","pull_request_name":"Self","author_id":"tr9","assigned_reviewers":["tr9"]}`,
+			`{"kind":"pull_request","pull_request_id":"tr-pr-2","pull_request_name":"Merged","author_id":"tr2","status":"OPEN"}`,
+		}, "\n")))
+
+		result, err := svc.Import(ctx, src, false)
+		require.ErrorIs(t, err, usecase.ErrImportConflict)
+
+		byLine := make(map[int]entity.ImportConflict)
+		for _, c := range result.Conflicts {
+			byLine[c.Line] = c
+		}
+		assert.Equal(t, entity.ImportConflict{Line: 1, Kind: entity.ConflictUser, ID: "tr1", Reason: "user belongs to team transfer"}, byLine[1])
+		assert.Equal(t, "author cannot review own PR", byLine[3].Reason)
+		assert.Equal(t, "tr-pr-2", byLine[4].ID)
+
+		_, err = svc.GetTeam(ctx, "transfer-new")
+		assert.ErrorIs(t, err, usecase.ErrTeamNotFound)
+	})
+
+	t.Run("Conflict after the first batch rolls back written batches", func(t *testing.T) {
+		// Больше одной пачки: первые команды успевают записаться до конфликта в последней строке
+		var lines []string
+		for i := range 600 {
+			lines = append(lines, fmt.Sprintf(`{"kind":"team","team_name":"batch-%d","members":[{"user_id":"batch-u%d","username":"U","is_active":true}]}`, i, i))
+		}
+		lines = append(lines, `{"kind":"team","team_name":"batch-0"}`)
+
+		result, err := svc.Import(ctx, transfer.NewJSONLReader(strings.NewReader(strings.Join(lines, "\n"))), false)
+		require.ErrorIs(t, err, usecase.ErrImportConflict)
+		require.Len(t, result.Conflicts, 1)
+		assert.Equal(t, 601, result.Conflicts[0].Line)
+
+		_, err = svc.GetTeam(ctx, "batch-0")
+		assert.ErrorIs(t, err, usecase.ErrTeamNotFound)
+	})
+
+	t.Run("Malformed line aborts the import", func(t *testing.T) {
+		src := transfer.NewJSONLReader(strings.NewReader(
+			`{"kind":"team","team_name":"broken","members":[]}` + "\n" + `{"kind":"team"`))
+		_, err := svc.Import(ctx, src, false)
+		require.ErrorIs(t, err, usecase.ErrInvalidImport)
+		assert.Contains(t, err.Error(), "line 2")
+
+		_, err = svc.GetTeam(ctx, "broken")
+		assert.ErrorIs(t, err, usecase.ErrTeamNotFound)
+	})
+
+	t.Run("Export reads one snapshot", func(t *testing.T) {
+		// Команда и её PR, созданные во время выгрузки, в неё не попадают: иначе PR сослался бы на автора,
+		// которого нет в файле
+		var buf bytes.Buffer
+		enc := transfer.NewWriter(&buf, transfer.FormatJSONL)
+		added := false
+		err := svc.Export(ctx, func(rec entity.TransferRecord) error {
+			if !added {
+				added = true
+				_, err := svc.AddOrUpdateTeam(ctx, entity.Team{Name: "mid-export",
+					Members: []entity.User{{ID: "mx1", Username: "Mid", IsActive: true}}})
+				require.NoError(t, err)
+				_, err = svc.CreatePR(ctx, "mx-pr-1", "Late", "mx1", entity.NoCandidateEmpty)
+				require.NoError(t, err)
+			}
+			return enc.Write(rec)
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, buf.String(), "mid-export")
+		assert.NotContains(t, buf.String(), "mx-pr-1")
+		assert.Contains(t, export(transfer.FormatJSONL), "mx-pr-1")
+	})
+
+	t.Run("Unknown fields are rejected with a line number", func(t *testing.T) {
+		_, err := transfer.ReadJSONL(strings.NewReader("\n" + `{"kind":"team","team_name":"x","color":"red"}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 2")
+
+		_, err = transfer.ReadCSV(strings.NewReader("kind,team_name,color\nteam,x,red\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 1")
+	})
+}
+
+func TestExportImportHTTP(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	resp := client.post(t, "/team/add", gen.Team{
+		TeamName: "http-transfer",
+		Members: []gen.TeamMember{
+			{UserId: "ht1", Username: "Alice", IsActive: true},
+			{UserId: "ht2", Username: "Bob", IsActive: true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp = client.post(t, "/pullRequest/create", map[string]string{
+		"pull_request_id": "ht-pr-1", "pull_request_name": "Feature", "author_id": "ht1",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	importFile := func(t *testing.T, query, contentType, body string) (*http.Response, gen.ImportReport) {
+		req, err := http.NewRequest(http.MethodPost, client.baseURL+"/admin/import"+query, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		resp, err := client.client.Do(req)
+		require.NoError(t, err)
+
+		var report gen.ImportReport
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+		}
+		return resp, report
+	}
+
+	resp = client.get(t, "/admin/export?format=csv")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	csvDump, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(csvDump), "kind,team_name,user_id,"))
+	assert.Contains(t, string(csvDump), "ht-pr-1,Feature,ht1,OPEN")
+
+	t.Run("Export defaults to JSONL", func(t *testing.T) {
+		resp := client.get(t, "/admin/export")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+		records, err := transfer.ReadJSONL(resp.Body)
+		require.NoError(t, err)
+		assert.Len(t, records, 2)
+	})
+
+	t.Run("Dry run reports changes without writing", func(t *testing.T) {
+		// Строки одной команды должны идти подряд, поэтому новый участник — перед строкой PR
+		body := strings.Replace(string(csvDump), "\npull_request,", "\nteam,http-transfer,ht3,Carol,true,,,,,,,\npull_request,", 1)
+		resp, report := importFile(t, "?dry_run=true", "text/csv", body)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, gen.ImportCounts{Updated: 1}, report.Teams)
+		assert.Equal(t, gen.ImportCounts{Created: 1, Unchanged: 2}, report.Users)
+		assert.Equal(t, gen.ImportCounts{Unchanged: 1}, report.PullRequests)
+		assert.Empty(t, report.Conflicts)
+
+		resp = client.get(t, "/team/get?team_name=http-transfer")
+		var team gen.Team
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&team))
+		assert.Len(t, team.Members, 2)
+
+		resp, report = importFile(t, "", "text/csv", body)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.False(t, report.DryRun)
+
+		resp = client.get(t, "/team/get?team_name=http-transfer")
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&team))
+		assert.Len(t, team.Members, 3)
+	})
+
+	t.Run("Conflicts → 409 with the list", func(t *testing.T) {
+		body := `{"kind":"pull_request","pull_request_id":"ht-pr-1","pull_request_name":"Feature","author_id":"ht3","assigned_reviewers":["ht2"]}` + "\n" +
+			`{"kind":"pull_request","pull_request_id":"ht-pr-2","pull_request_name":"Ghost","author_id":"nobody"}` + "\n"
+		resp, report := importFile(t, "", "application/x-ndjson", body)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		require.Len(t, report.Conflicts, 2)
+		assert.Equal(t, gen.ImportConflict{Line: 1, Kind: gen.ImportConflictKindPullRequest, Id: "ht-pr-1",
+			Reason: "author is ht1, import cannot change it"}, report.Conflicts[0])
+		assert.Equal(t, "user nobody not found", report.Conflicts[1].Reason)
+	})
+
+	t.Run("Malformed file → 400 with the line", func(t *testing.T) {
+		resp, _ := importFile(t, "", "application/x-ndjson", `{"kind":"team"`+"\n")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var errResp gen.ErrorResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+		require.NotNil(t, errResp.Error.Details)
+		assert.Contains(t, (*errResp.Error.Details)[0].Message, "line 1")
+	})
+
+	t.Run("Unsupported content type → 400", func(t *testing.T) {
+		resp, _ := importFile(t, "", "application/json", `{}`)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
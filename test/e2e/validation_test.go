@@ -0,0 +1,109 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeValidationError(t *testing.T, resp *http.Response) gen.ErrorResponse {
+	t.Helper()
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var body gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, gen.VALIDATIONERROR, body.Error.Code)
+	require.NotNil(t, body.Error.Details)
+	require.NotEmpty(t, *body.Error.Details)
+	return body
+}
+
+func fields(body gen.ErrorResponse) []string {
+	var res []string
+	for _, d := range *body.Error.Details {
+		res = append(res, d.Field)
+	}
+	return res
+}
+
+func TestRequestValidation(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	t.Run("Empty ids in PR create", func(t *testing.T) {
+		resp := client.post(t, "/pullRequest/create", map[string]any{
+			"pull_request_id":   "",
+			"pull_request_name": "Feature",
+			"author_id":         "",
+		})
+		body := decodeValidationError(t, resp)
+		assert.ElementsMatch(t, []string{"pull_request_id", "author_id"}, fields(body))
+	})
+
+	t.Run("Missing required field", func(t *testing.T) {
+		resp := client.post(t, "/pullRequest/reassign", map[string]any{
+			"pull_request_id": "pr-1",
+		})
+		body := decodeValidationError(t, resp)
+		assert.Equal(t, []string{"old_user_id"}, fields(body))
+	})
+
+	t.Run("Wrong field type", func(t *testing.T) {
+		resp := client.post(t, "/users/setIsActive", map[string]any{
+			"user_id":   "u1",
+			"is_active": "yes",
+		})
+		body := decodeValidationError(t, resp)
+		assert.Equal(t, []string{"is_active"}, fields(body))
+	})
+
+	t.Run("Nested member field", func(t *testing.T) {
+		resp := client.post(t, "/team/add", map[string]any{
+			"team_name": "validation-team",
+			"members": []map[string]any{
+				{"user_id": "", "username": "Alice", "is_active": true},
+			},
+		})
+		body := decodeValidationError(t, resp)
+		assert.Equal(t, []string{"members.0.user_id"}, fields(body))
+	})
+
+	t.Run("Malformed JSON", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, client.baseURL+"/pullRequest/merge", bytes.NewBufferString("{"))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.client.Do(req)
+		require.NoError(t, err)
+		body := decodeValidationError(t, resp)
+		assert.Equal(t, []string{"body"}, fields(body))
+	})
+
+	t.Run("Empty query parameter", func(t *testing.T) {
+		resp := client.get(t, "/team/get?team_name=")
+		body := decodeValidationError(t, resp)
+		assert.Equal(t, []string{"team_name"}, fields(body))
+	})
+
+	t.Run("Missing query parameter", func(t *testing.T) {
+		resp := client.get(t, "/users/stats")
+		body := decodeValidationError(t, resp)
+		assert.Equal(t, []string{"user_id"}, fields(body))
+	})
+
+	t.Run("Valid request passes through", func(t *testing.T) {
+		resp := client.get(t, "/team/get?team_name=missing")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
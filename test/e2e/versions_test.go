@@ -0,0 +1,181 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/mark47B/be-internship/internal/domain/usecase"
+	"github.com/mark47B/be-internship/internal/infra/storage/pg"
+	"github.com/mark47B/be-internship/internal/infra/transport/rest/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimisticConcurrency(t *testing.T) {
+	db := setupTestDB(t)
+	client := newTestClient(db)
+	t.Cleanup(client.Close)
+
+	withETag := func(etag string) map[string]string {
+		return map[string]string{"X-API-Key": adminAPIKey, "If-Match": etag}
+	}
+	reviewerOf := func(t *testing.T, resp *http.Response) string {
+		defer resp.Body.Close()
+		var body struct {
+			Pr gen.PullRequest `json:"pr"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.NotEmpty(t, body.Pr.AssignedReviewers)
+		return body.Pr.AssignedReviewers[0]
+	}
+
+	resp := client.post(t, "/team/add", gen.Team{
+		TeamName: "occ",
+		Members: []gen.TeamMember{
+			{UserId: "occ-a", Username: "A", IsActive: true},
+			{UserId: "occ-r1", Username: "R1", IsActive: true},
+			{UserId: "occ-r2", Username: "R2", IsActive: true},
+			{UserId: "occ-r3", Username: "R3", IsActive: true},
+			{UserId: "occ-r4", Username: "R4", IsActive: true},
+			{UserId: "occ-r5", Username: "R5", IsActive: true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, `"1"`, resp.Header.Get("ETag"))
+	resp.Body.Close()
+
+	resp = client.post(t, "/pullRequest/create", map[string]any{
+		"pull_request_id": "pr-occ", "pull_request_name": "occ", "author_id": "occ-a",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, `"1"`, resp.Header.Get("ETag"))
+	resp.Body.Close()
+
+	t.Run("Read endpoint returns ETag", func(t *testing.T) {
+		resp := client.get(t, "/pullRequest/get?pull_request_id=pr-occ")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `"1"`, resp.Header.Get("ETag"))
+		resp.Body.Close()
+
+		resp = client.get(t, "/team/get?team_name=occ")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `"1"`, resp.Header.Get("ETag"))
+		resp.Body.Close()
+	})
+
+	t.Run("Reassign with current ETag bumps version, stale ETag → 412", func(t *testing.T) {
+		reviewer := reviewerOf(t, client.get(t, "/pullRequest/get?pull_request_id=pr-occ"))
+
+		resp := client.doAs(t, http.MethodPost, "/pullRequest/reassign",
+			map[string]any{"pull_request_id": "pr-occ", "old_user_id": reviewer}, withETag(`"1"`))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `"2"`, resp.Header.Get("ETag"))
+		next := reviewerOf(t, resp)
+
+		resp = client.doAs(t, http.MethodPost, "/pullRequest/reassign",
+			map[string]any{"pull_request_id": "pr-occ", "old_user_id": next}, withETag(`"1"`))
+		requireErrorCode(t, resp, http.StatusPreconditionFailed, gen.PRECONDITIONFAILED)
+	})
+
+	t.Run("Concurrent reassigns with the same ETag: one wins", func(t *testing.T) {
+		resp := client.get(t, "/pullRequest/get?pull_request_id=pr-occ")
+		etag := resp.Header.Get("ETag")
+		reviewer := reviewerOf(t, resp)
+
+		statuses := make([]int, 2)
+		var wg sync.WaitGroup
+		for i := range statuses {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				resp := client.doAs(t, http.MethodPost, "/pullRequest/reassign",
+					map[string]any{"pull_request_id": "pr-occ", "old_user_id": reviewer}, withETag(etag))
+				resp.Body.Close()
+				statuses[i] = resp.StatusCode
+			}(i)
+		}
+		wg.Wait()
+
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusPreconditionFailed}, statuses)
+	})
+
+	t.Run("Merge checks If-Match", func(t *testing.T) {
+		body := map[string]any{"pull_request_id": "pr-occ"}
+
+		resp := client.doAs(t, http.MethodPost, "/pullRequest/merge", body, withETag(`"2"`))
+		requireErrorCode(t, resp, http.StatusPreconditionFailed, gen.PRECONDITIONFAILED)
+
+		resp = client.get(t, "/pullRequest/get?pull_request_id=pr-occ")
+		etag := resp.Header.Get("ETag")
+		resp.Body.Close()
+
+		resp = client.doAs(t, http.MethodPost, "/pullRequest/merge", body, withETag(etag))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+		resp.Body.Close()
+	})
+
+	t.Run("Team deactivation checks If-Match", func(t *testing.T) {
+		body := map[string]any{"user_ids": []string{"occ-r5"}}
+
+		resp := client.doAs(t, http.MethodPatch, "/teams/occ/deactivate-members", body, withETag(`"42"`))
+		requireErrorCode(t, resp, http.StatusPreconditionFailed, gen.PRECONDITIONFAILED)
+
+		resp = client.get(t, "/team/get?team_name=occ")
+		etag := resp.Header.Get("ETag")
+		resp.Body.Close()
+
+		resp = client.doAs(t, http.MethodPatch, "/teams/occ/deactivate-members", body, withETag(etag))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		resp = client.get(t, "/team/get?team_name=occ")
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+		resp.Body.Close()
+	})
+
+	t.Run("Member activity is versioned per user", func(t *testing.T) {
+		resp := client.get(t, "/team/get?team_name=occ")
+		etag := resp.Header.Get("ETag")
+		resp.Body.Close()
+
+		// Разные участники одной команды не конфликтуют между собой
+		statuses := make([]int, 2)
+		var wg sync.WaitGroup
+		for i, user := range []string{"occ-r1", "occ-r2"} {
+			wg.Add(1)
+			go func(i int, user string) {
+				defer wg.Done()
+				resp := client.post(t, "/users/setIsActive", map[string]any{"user_id": user, "is_active": false})
+				resp.Body.Close()
+				statuses[i] = resp.StatusCode
+			}(i, user)
+		}
+		wg.Wait()
+		assert.Equal(t, []int{http.StatusOK, http.StatusOK}, statuses)
+
+		resp = client.get(t, "/team/get?team_name=occ")
+		assert.Equal(t, etag, resp.Header.Get("ETag"))
+		resp.Body.Close()
+
+		// Запись с устаревшей версией пользователя — 412
+		users := pg.NewUserStorage(db)
+		user, err := users.Get(context.Background(), "occ-r1")
+		require.NoError(t, err)
+		require.NoError(t, users.Update(context.Background(), user))
+		user.IsActive = true
+		require.ErrorIs(t, users.Update(context.Background(), user), usecase.ErrVersionMismatch)
+	})
+
+	t.Run("Malformed If-Match → 400", func(t *testing.T) {
+		resp := client.doAs(t, http.MethodPost, "/pullRequest/merge",
+			map[string]any{"pull_request_id": "pr-occ"}, withETag("not-an-etag"))
+		requireErrorCode(t, resp, http.StatusBadRequest, gen.VALIDATIONERROR)
+	})
+}